@@ -0,0 +1,70 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosBlackoutSpec defines the desired state of ChaosBlackout
+// A ChaosBlackout holds back the ChaosEngines of the selected namespaces during its recurring time windows
+type ChaosBlackoutSpec struct {
+	// Windows are the recurring time windows during which no chaos is launched
+	Windows []BlackoutWindow `json:"windows"`
+	// TimeZone is the IANA name of the time zone used to evaluate the schedules of the windows
+	// it defaults to the time zone of the chaos-operator
+	TimeZone string `json:"timeZone,omitempty"`
+	// NamespaceSelector selects the namespaces whose ChaosEngines are held back, all the namespaces if not provided
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// AbortRunning tells the operator to abort the ChaosEngines which are already running when a window starts
+	AbortRunning bool `json:"abortRunning,omitempty"`
+}
+
+// BlackoutWindow is a recurring time window of a ChaosBlackout
+type BlackoutWindow struct {
+	// Schedule is the cron expression, in the standard five field format, at which the window starts
+	Schedule string `json:"schedule"`
+	// Duration of the window, such as 2h or 30m
+	Duration metav1.Duration `json:"duration"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +resource:path=chaosblackout
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+
+// ChaosBlackout is the Schema for the chaosblackouts API
+type ChaosBlackout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ChaosBlackoutSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChaosBlackoutList contains a list of ChaosBlackout
+type ChaosBlackoutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosBlackout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosBlackout{}, &ChaosBlackoutList{})
+}
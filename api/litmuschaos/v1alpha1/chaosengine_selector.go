@@ -0,0 +1,83 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// podFieldSelectors contains the fields of the pods supported by the field selectors
+var podFieldSelectors = map[string]bool{
+	"metadata.name":            true,
+	"metadata.namespace":       true,
+	"spec.nodeName":            true,
+	"spec.restartPolicy":       true,
+	"spec.schedulerName":       true,
+	"spec.serviceAccountName":  true,
+	"spec.hostNetwork":         true,
+	"status.phase":             true,
+	"status.podIP":             true,
+	"status.nominatedNodeName": true,
+}
+
+// GetLabelSelector returns the label selector of the workload, combining its Labels and LabelSelector
+func (w *Workload) GetLabelSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(w.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid labels %q: %v", w.Labels, err)
+	}
+	if w.LabelSelector == nil {
+		return selector, nil
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(w.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid labelSelector: %v", err)
+	}
+	requirements, _ := labelSelector.Requirements()
+	return selector.Add(requirements...), nil
+}
+
+// GetNamespaceSelector returns the selector of the namespaces of the workload, or nil if it isn't provided
+func (w *Workload) GetNamespaceSelector() (labels.Selector, error) {
+	if w.NamespaceSelector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(w.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespaceSelector: %v", err)
+	}
+	return selector, nil
+}
+
+// GetFieldSelector returns the field selector of the pods of the workload
+func (w *Workload) GetFieldSelector() (fields.Selector, error) {
+	selector, err := fields.ParseSelector(w.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fieldSelector %q: %v", w.FieldSelector, err)
+	}
+	for _, requirement := range selector.Requirements() {
+		if !podFieldSelectors[requirement.Field] {
+			return nil, fmt.Errorf("invalid fieldSelector %q: field %s is not supported for pods", w.FieldSelector, requirement.Field)
+		}
+	}
+	return selector, nil
+}
@@ -44,8 +44,19 @@ type ChaosEngineSpec struct {
 	EngineState EngineState `json:"engineState"`
 	// TerminationGracePeriodSeconds contains terminationGracePeriod for the chaos resources
 	TerminationGracePeriodSeconds int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ActiveDeadlineSeconds is the duration in seconds, relative to the creation of the chaos-runner,
+	// after which the chaos is forcefully aborted
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds,omitempty"`
 	// Selectors contains the target application details
 	Selectors *Selector `json:"selectors,omitempty"`
+	// MaxTargetPercentage is the maximum percentage of the pods in each of the target namespaces,
+	// and of the nodes in the cluster, which can be targeted by the chaos
+	MaxTargetPercentage int `json:"maxTargetPercentage,omitempty"`
+	// MaxTargetCount is the maximum number of pods and nodes which can be targeted by the chaos
+	MaxTargetCount int `json:"maxTargetCount,omitempty"`
+	// Priority of the ChaosEngine in the queue of the ChaosEngines waiting for the concurrency limits
+	// of the operator, the ChaosEngines with a higher priority are launched first
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // EngineState provides interface for all supported strings in spec.EngineState
@@ -86,6 +97,14 @@ const (
 	EngineStatusCompleted EngineStatus = "completed"
 	// EngineStatusStopped is used for reconcile calls to start reconcile for delete
 	EngineStatusStopped EngineStatus = "stopped"
+	// EngineStatusStopping is used while the chaos resources of an aborted ChaosEngine are being terminated
+	EngineStatusStopping EngineStatus = "stopping"
+	// EngineStatusError is used when the chaos-runner pod fails to execute the experiments
+	EngineStatusError EngineStatus = "error"
+	// EngineStatusBlocked is used while the launch of the chaos-runner is held back by the guardrails of the operator
+	EngineStatusBlocked EngineStatus = "blocked"
+	// EngineStatusQueued is used while the ChaosEngine waits for the locks of its targets, held by another ChaosEngine
+	EngineStatusQueued EngineStatus = "queued"
 )
 
 // CleanUpPolicy defines the garbage collection method used by chaos-operator
@@ -106,7 +125,42 @@ type ChaosEngineStatus struct {
 	EngineStatus EngineStatus `json:"engineStatus"`
 	//Detailed status of individual experiments
 	Experiments []ExperimentStatuses `json:"experiments"`
-}
+	// Verdict is the overall verdict of the experiments, derived from their chaosresults
+	Verdict ResultVerdict `json:"verdict,omitempty"`
+	// ObservedGeneration is the most recent generation of the ChaosEngine observed by the operator
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of the ChaosEngine's state
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Targets contains the workloads, pods and nodes resolved from the selectors of the ChaosEngine
+	Targets *TargetsStatus `json:"targets,omitempty"`
+	// QueuePosition is the position of the ChaosEngine in the queue of the ChaosEngines waiting for the
+	// concurrency limits of the operator, starting from 1
+	QueuePosition int `json:"queuePosition,omitempty"`
+	// PolicyViolations are the violations of the ChaosPolicies found by the operator before launching the ChaosEngine
+	PolicyViolations []string `json:"policyViolations,omitempty"`
+}
+
+// Condition types of the ChaosEngine
+const (
+	// ChaosEngineConditionInitialized is set once the ChaosEngine is initialized by the operator
+	ChaosEngineConditionInitialized = "Initialized"
+	// ChaosEngineConditionRunnerScheduled is set once the chaos-runner pod is created
+	ChaosEngineConditionRunnerScheduled = "RunnerScheduled"
+	// ChaosEngineConditionRunning is set while the chaos-runner pod is running
+	ChaosEngineConditionRunning = "Running"
+	// ChaosEngineConditionCompleted is set once the chaos-runner completes the experiments
+	ChaosEngineConditionCompleted = "Completed"
+	// ChaosEngineConditionAborted is set once the ChaosEngine is stopped before completion
+	ChaosEngineConditionAborted = "Aborted"
+	// ChaosEngineConditionFailed is set when the operator is unable to run the chaos
+	ChaosEngineConditionFailed = "Failed"
+	// ChaosEngineConditionBlocked is set while the launch of the chaos-runner is held back by the guardrails of the operator
+	ChaosEngineConditionBlocked = "Blocked"
+	// ChaosEngineConditionQueued is set while the ChaosEngine waits for the locks of its targets, held by another ChaosEngine
+	ChaosEngineConditionQueued = "Queued"
+)
 
 // ApplicationParams defines information about Application-Under-Test (AUT) on the cluster
 // Controller expects AUT to be annotated with litmuschaos.io/chaos: "true" to run chaos
@@ -122,6 +176,7 @@ type ApplicationParams struct {
 type Selector struct {
 	Workloads []Workload `json:"workloads,omitempty"`
 	Pods      []Pod      `json:"pods,omitempty"`
+	Nodes     []Node     `json:"nodes,omitempty"`
 }
 
 type WorkloadKind string
@@ -132,13 +187,27 @@ const (
 	WorkloadDaemonSet        WorkloadKind = "daemonSet"
 	WorkloadDeploymentConfig WorkloadKind = "deploymentconfig"
 	WorkloadRollout          WorkloadKind = "rollout"
+	WorkloadJob              WorkloadKind = "job"
+	WorkloadCronJob          WorkloadKind = "cronjob"
+	WorkloadReplicaSet       WorkloadKind = "replicaset"
 )
 
 type Workload struct {
 	Kind      WorkloadKind `json:"kind"`
-	Namespace string       `json:"namespace"`
+	Namespace string       `json:"namespace,omitempty"`
 	Names     string       `json:"names,omitempty"`
 	Labels    string       `json:"labels,omitempty"`
+	// APIVersion of the workload, which selects a generic workload kind, such as the
+	// VirtualMachine of kubevirt.io/v1, whose pods are resolved through their owner references
+	APIVersion string `json:"apiVersion,omitempty"`
+	// LabelSelector selects the workloads by their labels, including match expressions.
+	// It is combined with the Labels, if both are provided
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// NamespaceSelector selects the namespaces of the workloads by their labels,
+	// it is used instead of the Namespace to target the workloads across namespaces
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// FieldSelector selects the pods of the workloads by their fields, such as spec.nodeName
+	FieldSelector string `json:"fieldSelector,omitempty"`
 }
 
 type Pod struct {
@@ -146,11 +215,25 @@ type Pod struct {
 	Names     string `json:"names"`
 }
 
+// Node selects the target nodes of the node-level experiments, by one out of
+// their names, their labels or the workload whose pods they are hosting
+type Node struct {
+	// Names of the nodes, comma separated
+	Names string `json:"names,omitempty"`
+	// LabelSelector selects the nodes by their labels
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Workload selects the nodes hosting the pods of the workload
+	Workload *Workload `json:"workload,omitempty"`
+	// AllowControlPlane allows the control-plane nodes to be targeted
+	AllowControlPlane bool `json:"allowControlPlane,omitempty"`
+}
+
 // ComponentParams defines information about the runner
 type ComponentParams struct {
 	//Contains information of the runner pod
 	Runner RunnerInfo `json:"runner"`
-	// Contains information of the sidecar
+	// Contains information of the sidecar. The sidecars are terminated once
+	// the chaos-runner container has finished, as they don't exit by themselves
 	Sidecar []Sidecar `json:"sidecar,omitempty"`
 }
 
@@ -477,10 +560,42 @@ type ExperimentStatuses struct {
 	Status ExperimentStatus `json:"status"`
 	//Result of a completed chaos experiment
 	Verdict string `json:"verdict"`
+	// Phase of the chaos experiment, as reported by its chaosresult
+	Phase ResultPhase `json:"phase,omitempty"`
+	// ProbeSuccessPercentage of the chaos experiment, as reported by its chaosresult
+	ProbeSuccessPercentage string `json:"probeSuccessPercentage,omitempty"`
+	// ErrorOutput of the chaos experiment, as reported by its chaosresult
+	ErrorOutput *ErrorOutput `json:"errorOutput,omitempty"`
 	//Time of last state change of chaos experiment
 	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
+// TargetsStatus contains the targets resolved from the selectors of the ChaosEngine
+type TargetsStatus struct {
+	// Workloads selected by the ChaosEngine, or owning its target pods
+	Workloads []ResolvedTarget `json:"workloads,omitempty"`
+	// Pods targeted by the ChaosEngine
+	Pods []ResolvedTarget `json:"pods,omitempty"`
+	// Nodes targeted by the ChaosEngine
+	Nodes []ResolvedTarget `json:"nodes,omitempty"`
+	// Count is the number of the pods and nodes targeted by the ChaosEngine
+	Count int `json:"count"`
+	// LastUpdateTime is the time at which the targets were last resolved
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ResolvedTarget identifies a target resolved from the selectors of the ChaosEngine
+type ResolvedTarget struct {
+	// Kind of the target
+	Kind string `json:"kind"`
+	// APIVersion of the target
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Namespace of the target, empty for the nodes
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the target
+	Name string `json:"name"`
+}
+
 // +genclient
 // +resource:path=chaosengine
 //+kubebuilder:object:root=true
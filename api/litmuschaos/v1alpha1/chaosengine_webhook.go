@@ -0,0 +1,415 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// DefaultChaosRunnerImage is the runner image used if it is neither set inside the ChaosEngine nor by the operator
+const DefaultChaosRunnerImage = "litmuschaos/chaos-runner:latest"
+
+// AppKindAny is the appkind targeting the application of any kind, which is defaulted if the appinfo only provides the appns
+const AppKindAny = "KIND"
+
+// probeTypes maps the supported probe types to the field holding their inputs
+var probeTypes = map[string]string{
+	"k8sProbe":  "k8sProbe/inputs",
+	"httpProbe": "httpProbe/inputs",
+	"cmdProbe":  "cmdProbe/inputs",
+	"promProbe": "promProbe/inputs",
+	"sloProbe":  "sloProbe/inputs",
+}
+
+// workloadKinds contains the supported workload kinds, in lower case, which don't need an apiVersion
+var workloadKinds = map[string]bool{
+	"deployment":       true,
+	"statefulset":      true,
+	"daemonset":        true,
+	"deploymentconfig": true,
+	"rollout":          true,
+	"job":              true,
+	"cronjob":          true,
+	"replicaset":       true,
+}
+
+// IsIncomplete checks if the appinfo provides only one out of the appkind and applabel,
+// where the AppKindAny appkind doesn't need the applabel
+func (a ApplicationParams) IsIncomplete() bool {
+	if a.AppKind == AppKindAny {
+		return false
+	}
+	return (a.AppKind != "") != (a.Applabel != "")
+}
+
+// ChaosEngineValidator validates the ChaosEngine on creation and update
+// +kubebuilder:object:generate=false
+type ChaosEngineValidator struct {
+	// Client is used to look up the ChaosExperiments referred by the ChaosEngine, and the ChaosPolicies applying to it
+	Client client.Reader
+}
+
+// ChaosEngineDefaulter sets the default values of the ChaosEngine on creation and update
+// +kubebuilder:object:generate=false
+type ChaosEngineDefaulter struct {
+	// RunnerImage is the runner image of the operator, falling back to the DefaultChaosRunnerImage if empty
+	RunnerImage string
+}
+
+var _ webhook.CustomValidator = &ChaosEngineValidator{}
+var _ webhook.CustomDefaulter = &ChaosEngineDefaulter{}
+
+// SetupWebhookWithManager registers the webhooks of the ChaosEngine with the manager,
+// defaulting the runner image of the ChaosEngines to the runner image of the operator
+func (r *ChaosEngine) SetupWebhookWithManager(mgr ctrl.Manager, runnerImage string) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(&ChaosEngineDefaulter{RunnerImage: runnerImage}).
+		WithValidator(&ChaosEngineValidator{Client: mgr.GetAPIReader()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-litmuschaos-io-v1alpha1-chaosengine,mutating=true,failurePolicy=fail,sideEffects=None,groups=litmuschaos.io,resources=chaosengines,verbs=create;update,versions=v1alpha1,name=mchaosengine.litmuschaos.io,admissionReviewVersions=v1
+
+// Default sets the default values of the ChaosEngine upon creation and update
+func (d *ChaosEngineDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	engine, ok := obj.(*ChaosEngine)
+	if !ok {
+		return fmt.Errorf("expected a ChaosEngine but got a %T", obj)
+	}
+	engine.SetDefaults(d.RunnerImage)
+	return nil
+}
+
+// SetDefaults sets the default values of the ChaosEngine, so that the stored ChaosEngine shows the
+// values used by the operator. The runner image is defaulted to the given runner image of the operator,
+// falling back to the DefaultChaosRunnerImage
+func (r *ChaosEngine) SetDefaults(runnerImage string) {
+	if r.Spec.EngineState == "" {
+		r.Spec.EngineState = EngineStateActive
+	}
+
+	if r.Spec.Selectors == nil && r.Spec.Appinfo.Appns != "" && r.Spec.Appinfo.AppKind == "" && r.Spec.Appinfo.Applabel == "" {
+		r.Spec.Appinfo.AppKind = AppKindAny
+	}
+
+	if r.Spec.JobCleanUpPolicy == "" {
+		r.Spec.JobCleanUpPolicy = CleanUpPolicyRetain
+	}
+
+	if r.Spec.Components.Runner.Image == "" {
+		r.Spec.Components.Runner.Image = runnerImage
+		if r.Spec.Components.Runner.Image == "" {
+			r.Spec.Components.Runner.Image = DefaultChaosRunnerImage
+		}
+	}
+
+	if r.Spec.Components.Runner.ImagePullPolicy == "" {
+		r.Spec.Components.Runner.ImagePullPolicy = corev1.PullIfNotPresent
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-litmuschaos-io-v1alpha1-chaosengine,mutating=false,failurePolicy=fail,sideEffects=None,groups=litmuschaos.io,resources=chaosengines,verbs=create;update,versions=v1alpha1,name=vchaosengine.litmuschaos.io,admissionReviewVersions=v1
+
+// ValidateCreate validates the ChaosEngine upon creation
+func (v *ChaosEngineValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	engine, ok := obj.(*ChaosEngine)
+	if !ok {
+		return fmt.Errorf("expected a ChaosEngine but got a %T", obj)
+	}
+	return v.validate(ctx, engine)
+}
+
+// ValidateUpdate validates the ChaosEngine upon update. The updates which only change the
+// engineState, status or metadata of the ChaosEngine are not validated, as the operator
+// needs them to be able to stop the chaos at any point of time
+func (v *ChaosEngineValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	oldEngine, ok := oldObj.(*ChaosEngine)
+	if !ok {
+		return fmt.Errorf("expected a ChaosEngine but got a %T", oldObj)
+	}
+	engine, ok := newObj.(*ChaosEngine)
+	if !ok {
+		return fmt.Errorf("expected a ChaosEngine but got a %T", newObj)
+	}
+
+	oldSpec, newSpec := oldEngine.Spec.DeepCopy(), engine.Spec.DeepCopy()
+	oldSpec.EngineState, newSpec.EngineState = "", ""
+	if reflect.DeepEqual(oldSpec, newSpec) {
+		return nil
+	}
+	return v.validate(ctx, engine)
+}
+
+// ValidateDelete validates the ChaosEngine upon deletion
+func (v *ChaosEngineValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate returns an invalid error with all the field errors of the ChaosEngine
+func (v *ChaosEngineValidator) validate(ctx context.Context, engine *ChaosEngine) error {
+	allErrs := validateChaosEngineSpec(&engine.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, v.validateExperimentsExist(ctx, engine)...)
+	allErrs = append(allErrs, v.validateChaosPolicies(ctx, engine)...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return k8serrors.NewInvalid(SchemeGroupVersion.WithKind("ChaosEngine").GroupKind(), engine.Name, allErrs)
+}
+
+// validateChaosEngineSpec validates the spec of the ChaosEngine, which doesn't need any lookup
+func validateChaosEngineSpec(spec *ChaosEngineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch spec.EngineState {
+	case "", EngineStateActive, EngineStateStop:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("engineState"), spec.EngineState, []string{string(EngineStateActive), string(EngineStateStop)}))
+	}
+
+	switch spec.JobCleanUpPolicy {
+	case "", CleanUpPolicyDelete, CleanUpPolicyRetain:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("jobCleanUpPolicy"), spec.JobCleanUpPolicy, []string{string(CleanUpPolicyDelete), string(CleanUpPolicyRetain)}))
+	}
+
+	if spec.Appinfo.IsIncomplete() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("appinfo"), spec.Appinfo, "incomplete appinfo, provide appkind and applabel both"))
+	}
+
+	if spec.Selectors != nil && spec.Selectors.Workloads == nil && spec.Selectors.Pods == nil && spec.Selectors.Nodes == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selectors"), "specify one out of workloads, pods or nodes"))
+	}
+	if spec.Selectors != nil {
+		for i, w := range spec.Selectors.Workloads {
+			allErrs = append(allErrs, validateWorkload(w, fldPath.Child("selectors", "workloads").Index(i))...)
+		}
+		for i, n := range spec.Selectors.Nodes {
+			allErrs = append(allErrs, validateNode(n, fldPath.Child("selectors", "nodes").Index(i))...)
+		}
+	}
+
+	if spec.ActiveDeadlineSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("activeDeadlineSeconds"), spec.ActiveDeadlineSeconds, "must be greater than 0"))
+	}
+
+	if spec.MaxTargetPercentage < 0 || spec.MaxTargetPercentage > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTargetPercentage"), spec.MaxTargetPercentage, "must be between 0 and 100"))
+	}
+	if spec.MaxTargetCount < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTargetCount"), spec.MaxTargetCount, "must be greater than 0"))
+	}
+
+	if len(spec.Experiments) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("experiments"), "specify at least one experiment"))
+	}
+	for i, exp := range spec.Experiments {
+		expPath := fldPath.Child("experiments").Index(i)
+		if exp.Name == "" {
+			allErrs = append(allErrs, field.Required(expPath.Child("name"), "experiment name is required"))
+		}
+		for j, probe := range exp.Spec.Probe {
+			allErrs = append(allErrs, validateProbe(probe, expPath.Child("spec", "probe").Index(j))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkload validates the selectors of the workload
+func validateWorkload(w Workload, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if w.APIVersion != "" {
+		if _, err := schema.ParseGroupVersion(w.APIVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiVersion"), w.APIVersion, err.Error()))
+		}
+		if w.Kind == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "kind is required along with the apiVersion"))
+		}
+	} else if !workloadKinds[strings.ToLower(string(w.Kind))] {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), w.Kind, []string{"deployment", "statefulset", "daemonset", "deploymentconfig", "rollout", "job", "cronjob", "replicaset"}))
+	}
+
+	if w.Names == "" && w.Labels == "" && w.LabelSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "specify one out of names, labels or labelSelector"))
+	}
+
+	if w.Namespace != "" && w.NamespaceSelector != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("namespaceSelector"), "namespace and namespaceSelector can't be provided together"))
+	}
+	if w.Namespace == "" && w.NamespaceSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "specify one out of namespace or namespaceSelector"))
+	}
+
+	if _, err := (&Workload{Labels: w.Labels}).GetLabelSelector(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("labels"), w.Labels, err.Error()))
+	}
+	if w.LabelSelector != nil {
+		if _, err := (&Workload{LabelSelector: w.LabelSelector}).GetLabelSelector(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labelSelector"), w.LabelSelector, err.Error()))
+		}
+	}
+	if _, err := w.GetNamespaceSelector(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaceSelector"), w.NamespaceSelector, err.Error()))
+	}
+	if _, err := w.GetFieldSelector(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("fieldSelector"), w.FieldSelector, err.Error()))
+	}
+
+	return allErrs
+}
+
+// validateNode validates the selectors of the node
+func validateNode(n Node, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	selectors := 0
+	for _, provided := range []bool{n.Names != "", n.LabelSelector != nil, n.Workload != nil} {
+		if provided {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, n, "specify one out of names, labelSelector or workload"))
+	}
+
+	if n.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(n.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labelSelector"), n.LabelSelector, err.Error()))
+		}
+	}
+	if n.Workload != nil {
+		allErrs = append(allErrs, validateWorkload(*n.Workload, fldPath.Child("workload"))...)
+	}
+
+	return allErrs
+}
+
+// validateProbe validates that the inputs provided for the probe match its type
+func validateProbe(probe ProbeAttributes, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if probe.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "probe name is required"))
+	}
+
+	inputs, ok := probeTypes[probe.Type]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), probe.Type, []string{"k8sProbe", "httpProbe", "cmdProbe", "promProbe", "sloProbe"}))
+		return allErrs
+	}
+
+	provided := map[string]bool{
+		"k8sProbe/inputs":  probe.K8sProbeInputs != nil,
+		"httpProbe/inputs": probe.HTTPProbeInputs != nil,
+		"cmdProbe/inputs":  probe.CmdProbeInputs != nil,
+		"promProbe/inputs": probe.PromProbeInputs != nil,
+		"sloProbe/inputs":  probe.SLOProbeInputs != nil,
+	}
+	if !provided[inputs] {
+		allErrs = append(allErrs, field.Required(fldPath.Child(inputs), fmt.Sprintf("inputs are required for the %s", probe.Type)))
+	}
+	for _, name := range []string{"k8sProbe/inputs", "httpProbe/inputs", "cmdProbe/inputs", "promProbe/inputs", "sloProbe/inputs"} {
+		if name != inputs && provided[name] {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(name), fmt.Sprintf("inputs can't be provided for the probe of type %s", probe.Type)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateExperimentsExist validates that the experiments listed in the ChaosEngine exist in its namespace
+func (v *ChaosEngineValidator) validateExperimentsExist(ctx context.Context, engine *ChaosEngine) field.ErrorList {
+	var allErrs field.ErrorList
+	if v.Client == nil {
+		return allErrs
+	}
+
+	for i, exp := range engine.Spec.Experiments {
+		if exp.Name == "" {
+			continue
+		}
+		if err := v.Client.Get(ctx, types.NamespacedName{Name: exp.Name, Namespace: engine.Namespace}, &ChaosExperiment{}); err != nil {
+			fldPath := field.NewPath("spec", "experiments").Index(i).Child("name")
+			if k8serrors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(fldPath, exp.Name))
+				continue
+			}
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		}
+	}
+
+	return allErrs
+}
+
+// validateChaosPolicies validates that the ChaosEngine satisfies the ChaosPolicies applying to its namespace
+func (v *ChaosEngineValidator) validateChaosPolicies(ctx context.Context, engine *ChaosEngine) field.ErrorList {
+	var allErrs field.ErrorList
+	if v.Client == nil {
+		return allErrs
+	}
+
+	policyList := &ChaosPolicyList{}
+	if err := v.Client.List(ctx, policyList); err != nil {
+		return append(allErrs, field.InternalError(field.NewPath("metadata", "namespace"), fmt.Errorf("unable to list chaospolicies: %v", err)))
+	}
+	if len(policyList.Items) == 0 {
+		return allErrs
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: engine.Namespace}, namespace); err != nil && !k8serrors.IsNotFound(err) {
+		return append(allErrs, field.InternalError(field.NewPath("metadata", "namespace"), err))
+	}
+
+	experiments := make(map[string]*ChaosExperiment)
+	for _, exp := range engine.Spec.Experiments {
+		experiment := &ChaosExperiment{}
+		if err := v.Client.Get(ctx, types.NamespacedName{Name: exp.Name, Namespace: engine.Namespace}, experiment); err == nil {
+			experiments[exp.Name] = experiment
+		}
+	}
+
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		applies, err := policy.AppliesTo(namespace.Labels)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(field.NewPath("metadata", "namespace"), err))
+			continue
+		}
+		if applies {
+			allErrs = append(allErrs, policy.Evaluate(engine, experiments)...)
+		}
+	}
+	return allErrs
+}
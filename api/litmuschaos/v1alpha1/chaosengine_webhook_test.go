@@ -0,0 +1,382 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateChaosEngine(t *testing.T) {
+	newEngine := func(spec ChaosEngineSpec) *ChaosEngine {
+		return &ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine",
+				Namespace: "default",
+			},
+			Spec: spec,
+		}
+	}
+	experiments := []ExperimentList{
+		{
+			Name: "pod-delete",
+		},
+	}
+
+	tests := map[string]struct {
+		engine        *ChaosEngine
+		expectedField string
+	}{
+		"Test Positive-1": {
+			engine: newEngine(ChaosEngineSpec{
+				Appinfo: ApplicationParams{
+					Applabel: "app=nginx",
+					AppKind:  "deployment",
+				},
+				Experiments: experiments,
+			}),
+		},
+		"Test Positive-2": {
+			engine: newEngine(ChaosEngineSpec{
+				Experiments: []ExperimentList{
+					{
+						Name: "pod-delete",
+						Spec: ExperimentAttributes{
+							Probe: []ProbeAttributes{
+								{
+									Name:            "check-frontend",
+									Type:            "httpProbe",
+									HTTPProbeInputs: &HTTPProbeInputs{URL: "http://frontend"},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		"Test Positive-3": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Workloads: []Workload{
+						{Kind: "VirtualMachine", APIVersion: "kubevirt.io/v1", Namespace: "vms", Names: "db-vm"},
+						{Kind: WorkloadCronJob, Namespace: "batch", Names: "nightly-report"},
+					},
+				},
+				Experiments: experiments,
+			}),
+		},
+		"Test Positive-4": {
+			// the defaulted appkind of the appinfo only providing the appns doesn't need the applabel
+			engine: newEngine(ChaosEngineSpec{
+				Appinfo: ApplicationParams{
+					Appns:   "shop",
+					AppKind: AppKindAny,
+				},
+				Experiments: experiments,
+			}),
+		},
+		"Test Negative-1": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors:   &Selector{},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors",
+		},
+		"Test Negative-2": {
+			engine: newEngine(ChaosEngineSpec{
+				Appinfo: ApplicationParams{
+					AppKind: "deployment",
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.appinfo",
+		},
+		"Test Negative-3": {
+			engine: newEngine(ChaosEngineSpec{
+				Experiments: []ExperimentList{
+					{
+						Name: "pod-cpu-hog",
+					},
+				},
+			}),
+			expectedField: "spec.experiments[0].name",
+		},
+		"Test Negative-4": {
+			engine: newEngine(ChaosEngineSpec{
+				Experiments: []ExperimentList{
+					{
+						Name: "pod-delete",
+						Spec: ExperimentAttributes{
+							Probe: []ProbeAttributes{
+								{
+									Name:           "check-frontend",
+									Type:           "httpProbe",
+									CmdProbeInputs: &CmdProbeInputs{Command: "ls"},
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedField: "spec.experiments[0].spec.probe[0].httpProbe/inputs",
+		},
+		"Test Negative-5": {
+			engine: newEngine(ChaosEngineSpec{
+				EngineState: "paused",
+				Experiments: experiments,
+			}),
+			expectedField: "spec.engineState",
+		},
+		"Test Negative-6": {
+			engine:        newEngine(ChaosEngineSpec{}),
+			expectedField: "spec.experiments",
+		},
+		"Test Negative-7": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Workloads: []Workload{
+						{
+							Kind:          WorkloadDeployment,
+							Labels:        "app=nginx",
+							FieldSelector: "spec.containers=nginx",
+						},
+					},
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors.workloads[0].fieldSelector",
+		},
+		"Test Negative-8": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Workloads: []Workload{
+						{
+							Kind:      WorkloadDeployment,
+							Namespace: "shop",
+							LabelSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{Key: "tier", Operator: metav1.LabelSelectorOpIn},
+								},
+							},
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"env": "staging"},
+							},
+						},
+					},
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors.workloads[0].labelSelector",
+		},
+		"Test Negative-10": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Workloads: []Workload{
+						{Kind: "VirtualMachine", Namespace: "vms", Names: "db-vm"},
+					},
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors.workloads[0].kind",
+		},
+		"Test Negative-11": {
+			engine: newEngine(ChaosEngineSpec{
+				Appinfo: ApplicationParams{
+					Applabel: "app=nginx",
+					AppKind:  "deployment",
+				},
+				MaxTargetPercentage: 120,
+				Experiments:         experiments,
+			}),
+			expectedField: "spec.maxTargetPercentage",
+		},
+		"Test Negative-12": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Workloads: []Workload{
+						{Kind: WorkloadDeployment, Labels: "app=nginx"},
+					},
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors.workloads[0].namespace",
+		},
+		"Test Negative-9": {
+			engine: newEngine(ChaosEngineSpec{
+				Selectors: &Selector{
+					Nodes: []Node{
+						{
+							Names:         "worker-1",
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "chaos"}},
+						},
+					},
+				},
+				Experiments: experiments,
+			}),
+			expectedField: "spec.selectors.nodes[0]",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := AddToScheme(s); err != nil {
+				t.Fatalf("Test %q failed: unable to build scheme: %v", name, err)
+			}
+			validator := &ChaosEngineValidator{
+				Client: fake.NewClientBuilder().WithScheme(s).WithObjects(&ChaosExperiment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod-delete",
+						Namespace: "default",
+					},
+				}).Build(),
+			}
+
+			err := validator.ValidateCreate(context.TODO(), mock.engine)
+			if mock.expectedField == "" && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if mock.expectedField != "" && (err == nil || !strings.Contains(err.Error(), mock.expectedField)) {
+				t.Fatalf("Test %q failed: expected error for field %s, got %v", name, mock.expectedField, err)
+			}
+		})
+	}
+}
+
+func TestValidateChaosEngineUpdate(t *testing.T) {
+	oldEngine := &ChaosEngine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "engine",
+			Namespace: "default",
+		},
+		Spec: ChaosEngineSpec{
+			EngineState: EngineStateActive,
+			Experiments: []ExperimentList{
+				{
+					Name: "deleted-experiment",
+				},
+			},
+		},
+	}
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	validator := &ChaosEngineValidator{Client: fake.NewClientBuilder().WithScheme(s).Build()}
+
+	newEngine := oldEngine.DeepCopy()
+	newEngine.Spec.EngineState = EngineStateStop
+	if err := validator.ValidateUpdate(context.TODO(), oldEngine, newEngine); err != nil {
+		t.Fatalf("expected the update of engineState to be allowed, got %v", err)
+	}
+
+	newEngine.Spec.JobCleanUpPolicy = CleanUpPolicyRetain
+	if err := validator.ValidateUpdate(context.TODO(), oldEngine, newEngine); err == nil {
+		t.Fatalf("expected the update of spec to be validated")
+	}
+}
+
+func TestChaosEngineDefault(t *testing.T) {
+	tests := map[string]struct {
+		engine      *ChaosEngine
+		runnerImage string
+		expected    ChaosEngineSpec
+	}{
+		"Test Positive-1": {
+			engine: &ChaosEngine{},
+			expected: ChaosEngineSpec{
+				EngineState:      EngineStateActive,
+				JobCleanUpPolicy: CleanUpPolicyRetain,
+				Components: ComponentParams{
+					Runner: RunnerInfo{
+						Image:           DefaultChaosRunnerImage,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+					},
+				},
+			},
+		},
+		"Test Positive-2": {
+			engine:      &ChaosEngine{},
+			runnerImage: "litmuschaos/chaos-runner:ci",
+			expected: ChaosEngineSpec{
+				EngineState:      EngineStateActive,
+				JobCleanUpPolicy: CleanUpPolicyRetain,
+				Components: ComponentParams{
+					Runner: RunnerInfo{
+						Image:           "litmuschaos/chaos-runner:ci",
+						ImagePullPolicy: corev1.PullIfNotPresent,
+					},
+				},
+			},
+		},
+		"Test Positive-4": {
+			// the appinfo only providing the appns targets the application of any kind
+			engine:      &ChaosEngine{Spec: ChaosEngineSpec{Appinfo: ApplicationParams{Appns: "shop"}}},
+			runnerImage: "litmuschaos/chaos-runner:ci",
+			expected: ChaosEngineSpec{
+				EngineState:      EngineStateActive,
+				JobCleanUpPolicy: CleanUpPolicyRetain,
+				Appinfo:          ApplicationParams{Appns: "shop", AppKind: AppKindAny},
+				Components: ComponentParams{
+					Runner: RunnerInfo{
+						Image:           "litmuschaos/chaos-runner:ci",
+						ImagePullPolicy: corev1.PullIfNotPresent,
+					},
+				},
+			},
+		},
+		"Test Positive-3": {
+			engine: &ChaosEngine{
+				Spec: ChaosEngineSpec{
+					EngineState:      EngineStateStop,
+					JobCleanUpPolicy: CleanUpPolicyDelete,
+					Components: ComponentParams{
+						Runner: RunnerInfo{
+							Image:           "fake-runner-image",
+							ImagePullPolicy: corev1.PullAlways,
+						},
+					},
+				},
+			},
+			runnerImage: "litmuschaos/chaos-runner:ci",
+			expected: ChaosEngineSpec{
+				EngineState:      EngineStateStop,
+				JobCleanUpPolicy: CleanUpPolicyDelete,
+				Components: ComponentParams{
+					Runner: RunnerInfo{
+						Image:           "fake-runner-image",
+						ImagePullPolicy: corev1.PullAlways,
+					},
+				},
+			},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			defaulter := &ChaosEngineDefaulter{RunnerImage: mock.runnerImage}
+			if err := defaulter.Default(context.TODO(), mock.engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if !reflect.DeepEqual(mock.expected, mock.engine.Spec) {
+				t.Fatalf("Test %q failed: expected spec %+v, received %+v", name, mock.expected, mock.engine.Spec)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// ChaosExperimentValidator validates the ChaosExperiment on creation and update
+// +kubebuilder:object:generate=false
+type ChaosExperimentValidator struct{}
+
+var _ webhook.CustomValidator = &ChaosExperimentValidator{}
+
+// SetupWebhookWithManager registers the webhooks of the ChaosExperiment with the manager
+func (r *ChaosExperiment) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&ChaosExperimentValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-litmuschaos-io-v1alpha1-chaosexperiment,mutating=false,failurePolicy=fail,sideEffects=None,groups=litmuschaos.io,resources=chaosexperiments,verbs=create;update,versions=v1alpha1,name=vchaosexperiment.litmuschaos.io,admissionReviewVersions=v1
+
+// ValidateCreate validates the ChaosExperiment upon creation
+func (v *ChaosExperimentValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return v.validate(obj)
+}
+
+// ValidateUpdate validates the ChaosExperiment upon update
+func (v *ChaosExperimentValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	return v.validate(newObj)
+}
+
+// ValidateDelete validates the ChaosExperiment upon deletion
+func (v *ChaosExperimentValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate returns an invalid error with all the field errors of the ChaosExperiment
+func (v *ChaosExperimentValidator) validate(obj runtime.Object) error {
+	experiment, ok := obj.(*ChaosExperiment)
+	if !ok {
+		return fmt.Errorf("expected a ChaosExperiment but got a %T", obj)
+	}
+
+	allErrs := validateExperimentDef(&experiment.Spec.Definition, field.NewPath("spec", "definition"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return k8serrors.NewInvalid(SchemeGroupVersion.WithKind("ChaosExperiment").GroupKind(), experiment.Name, allErrs)
+}
+
+// validateExperimentDef validates the definition of the ChaosExperiment
+func validateExperimentDef(def *ExperimentDef, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if def.Image == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("image"), "experiment image is required"))
+	}
+
+	switch def.ImagePullPolicy {
+	case "", corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("imagePullPolicy"), def.ImagePullPolicy, []string{string(corev1.PullAlways), string(corev1.PullIfNotPresent), string(corev1.PullNever)}))
+	}
+
+	switch def.Scope {
+	case "", "Namespaced", "Cluster":
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), def.Scope, []string{"Namespaced", "Cluster"}))
+	}
+
+	for i, cm := range def.ConfigMaps {
+		if cm.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMaps").Index(i).Child("name"), "configmap name is required"))
+		}
+		if cm.MountPath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMaps").Index(i).Child("mountPath"), "configmap mountPath is required"))
+		}
+	}
+
+	for i, secret := range def.Secrets {
+		if secret.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secrets").Index(i).Child("name"), "secret name is required"))
+		}
+		if secret.MountPath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secrets").Index(i).Child("mountPath"), "secret mountPath is required"))
+		}
+	}
+
+	for i, hostFile := range def.HostFileVolumes {
+		if hostFile.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("hostFileVolumes").Index(i).Child("name"), "hostFileVolume name is required"))
+		}
+		if hostFile.MountPath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("hostFileVolumes").Index(i).Child("mountPath"), "hostFileVolume mountPath is required"))
+		}
+		if hostFile.NodePath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("hostFileVolumes").Index(i).Child("nodePath"), "hostFileVolume nodePath is required"))
+		}
+	}
+
+	return allErrs
+}
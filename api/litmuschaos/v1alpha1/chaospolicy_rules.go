@@ -0,0 +1,189 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// defaultImageRegistry is the registry of the images which don't name their registry
+const defaultImageRegistry = "docker.io"
+
+// AppliesTo checks if the policy applies to the ChaosEngines of the namespace with the given labels
+func (p *ChaosPolicy) AppliesTo(namespaceLabels map[string]string) (bool, error) {
+	if p.Spec.NamespaceSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespaceSelector of chaospolicy %s: %v", p.Name, err)
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
+
+// Evaluate returns the violations of the policy by the ChaosEngine, whose ChaosExperiments are provided by name.
+// The ChaosExperiments which aren't provided are only checked against the allowed experiments
+func (p *ChaosPolicy) Evaluate(engine *ChaosEngine, experiments map[string]*ChaosExperiment) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if !p.isImageAllowed(engine.Spec.Components.Runner.Image) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("components", "runner", "image"), p.imageViolation(engine.Spec.Components.Runner.Image)))
+	}
+	for i, sidecar := range engine.Spec.Components.Sidecar {
+		if !p.isImageAllowed(sidecar.Image) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("components", "sidecar").Index(i).Child("image"), p.imageViolation(sidecar.Image)))
+		}
+	}
+
+	for i, exp := range engine.Spec.Experiments {
+		expPath := specPath.Child("experiments").Index(i)
+		if len(p.Spec.AllowedExperiments) != 0 && !containsString(p.Spec.AllowedExperiments, exp.Name) {
+			allErrs = append(allErrs, field.Forbidden(expPath.Child("name"), fmt.Sprintf("experiment %s isn't allowed by chaospolicy %s", exp.Name, p.Name)))
+		}
+
+		if image := exp.Spec.Components.ExperimentImage; image != "" {
+			if !p.isImageAllowed(image) {
+				allErrs = append(allErrs, field.Forbidden(expPath.Child("spec", "components", "experimentImage"), p.imageViolation(image)))
+			}
+		} else if experiment := experiments[exp.Name]; experiment != nil && !p.isImageAllowed(experiment.Spec.Definition.Image) {
+			allErrs = append(allErrs, field.Forbidden(expPath.Child("name"), fmt.Sprintf("image of chaosexperiment %s: %s", exp.Name, p.imageViolation(experiment.Spec.Definition.Image))))
+		}
+
+		if experiment := experiments[exp.Name]; experiment != nil {
+			if experiment.Spec.Definition.HostPID && !p.Spec.AllowHostPID {
+				allErrs = append(allErrs, field.Forbidden(expPath.Child("name"), fmt.Sprintf("chaosexperiment %s runs with hostPID, which isn't allowed by chaospolicy %s", exp.Name, p.Name)))
+			}
+			if privileged := experiment.Spec.Definition.SecurityContext.ContainerSecurityContext.Privileged; privileged != nil && *privileged && !p.Spec.AllowPrivileged {
+				allErrs = append(allErrs, field.Forbidden(expPath.Child("name"), fmt.Sprintf("chaosexperiment %s runs privileged, which isn't allowed by chaospolicy %s", exp.Name, p.Name)))
+			}
+		}
+
+		for j, probe := range exp.Spec.Probe {
+			if probe.CmdProbeInputs == nil || probe.CmdProbeInputs.Source == nil {
+				continue
+			}
+			sourcePath := expPath.Child("spec", "probe").Index(j).Child("cmdProbe/inputs", "source")
+			if !p.isImageAllowed(probe.CmdProbeInputs.Source.Image) {
+				allErrs = append(allErrs, field.Forbidden(sourcePath.Child("image"), p.imageViolation(probe.CmdProbeInputs.Source.Image)))
+			}
+			if probe.CmdProbeInputs.Source.Privileged && !p.Spec.AllowPrivileged {
+				allErrs = append(allErrs, field.Forbidden(sourcePath.Child("privileged"), fmt.Sprintf("privileged source pods aren't allowed by chaospolicy %s", p.Name)))
+			}
+		}
+	}
+
+	return append(allErrs, p.evaluateWorkloadKinds(&engine.Spec, specPath)...)
+}
+
+// evaluateWorkloadKinds returns the violations of the allowed workload kinds by the appinfo and selectors of the ChaosEngine
+func (p *ChaosPolicy) evaluateWorkloadKinds(spec *ChaosEngineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(p.Spec.AllowedWorkloadKinds) == 0 {
+		return allErrs
+	}
+
+	checkKind := func(kind string, kindPath *field.Path) {
+		if !p.isWorkloadKindAllowed(kind) {
+			allErrs = append(allErrs, field.Forbidden(kindPath, fmt.Sprintf("targeting the kind %s isn't allowed by chaospolicy %s", kind, p.Name)))
+		}
+	}
+
+	if spec.Selectors == nil {
+		if spec.Appinfo.AppKind != "" && spec.Appinfo.AppKind != AppKindAny {
+			checkKind(spec.Appinfo.AppKind, fldPath.Child("appinfo", "appkind"))
+		}
+		return allErrs
+	}
+	for i, w := range spec.Selectors.Workloads {
+		checkKind(string(w.Kind), fldPath.Child("selectors", "workloads").Index(i).Child("kind"))
+	}
+	for i := range spec.Selectors.Pods {
+		checkKind("pod", fldPath.Child("selectors", "pods").Index(i))
+	}
+	for i, n := range spec.Selectors.Nodes {
+		if n.Workload != nil {
+			checkKind(string(n.Workload.Kind), fldPath.Child("selectors", "nodes").Index(i).Child("workload", "kind"))
+			continue
+		}
+		checkKind("node", fldPath.Child("selectors", "nodes").Index(i))
+	}
+	return allErrs
+}
+
+// isWorkloadKindAllowed checks if the kind is one of the allowed workload kinds, ignoring the case
+func (p *ChaosPolicy) isWorkloadKindAllowed(kind string) bool {
+	for _, allowed := range p.Spec.AllowedWorkloadKinds {
+		if strings.EqualFold(allowed, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// isImageAllowed checks if the image comes from one of the allowed registries, or repository paths of a registry,
+// where a repository path also matches the tags and digests of the repository. The tags and digests aren't matched
+// after a bare registry, as myreg.io:5000 is another registry than myreg.io rather than a tag of it
+func (p *ChaosPolicy) isImageAllowed(image string) bool {
+	if len(p.Spec.AllowedImageRegistries) == 0 || image == "" {
+		return true
+	}
+	reference := normalizeImage(image)
+	for _, registry := range p.Spec.AllowedImageRegistries {
+		prefix := strings.TrimSuffix(registry, "/")
+		if reference == prefix || strings.HasPrefix(reference, prefix+"/") {
+			return true
+		}
+		if strings.Contains(prefix, "/") && (strings.HasPrefix(reference, prefix+":") || strings.HasPrefix(reference, prefix+"@")) {
+			return true
+		}
+	}
+	return false
+}
+
+// imageViolation describes the violation of the allowed registries by the image
+func (p *ChaosPolicy) imageViolation(image string) string {
+	return fmt.Sprintf("image %s isn't from the registries allowed by chaospolicy %s: %s", image, p.Name, strings.Join(p.Spec.AllowedImageRegistries, ","))
+}
+
+// normalizeImage prefixes the image with its registry, i.e. with docker.io if it doesn't name its
+// registry, so that litmuschaos/go-runner is matched by the docker.io/litmuschaos registry
+func normalizeImage(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return image
+	}
+	if len(parts) == 1 {
+		return defaultImageRegistry + "/library/" + image
+	}
+	return defaultImageRegistry + "/" + image
+}
+
+// containsString checks if the list contains the value
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChaosPolicyEvaluate(t *testing.T) {
+	privileged := true
+	experiments := map[string]*ChaosExperiment{
+		"pod-delete": {
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-delete"},
+			Spec:       ChaosExperimentSpec{Definition: ExperimentDef{Image: "litmuschaos/go-runner:3.0.0"}},
+		},
+		"node-cpu-hog": {
+			ObjectMeta: metav1.ObjectMeta{Name: "node-cpu-hog"},
+			Spec: ChaosExperimentSpec{Definition: ExperimentDef{
+				Image:           "registry.example.com/chaos/go-runner:3.0.0",
+				HostPID:         true,
+				SecurityContext: SecurityContext{ContainerSecurityContext: corev1.SecurityContext{Privileged: &privileged}},
+			}},
+		},
+	}
+	newEngine := func(experiments []ExperimentList, selectors *Selector) *ChaosEngine {
+		return &ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+			Spec: ChaosEngineSpec{
+				Components:  ComponentParams{Runner: RunnerInfo{Image: "litmuschaos/chaos-runner:3.0.0"}},
+				Selectors:   selectors,
+				Experiments: experiments,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		policy         ChaosPolicySpec
+		engine         *ChaosEngine
+		expectedFields []string
+	}{
+		"Test Positive-1": {
+			policy: ChaosPolicySpec{},
+			engine: newEngine([]ExperimentList{{Name: "pod-delete"}}, nil),
+		},
+		"Test Positive-2": {
+			policy: ChaosPolicySpec{
+				AllowedExperiments:     []string{"pod-delete", "node-cpu-hog"},
+				AllowedImageRegistries: []string{"docker.io/litmuschaos", "registry.example.com"},
+				AllowHostPID:           true,
+				AllowPrivileged:        true,
+				AllowedWorkloadKinds:   []string{"deployment", "node"},
+			},
+			engine: newEngine([]ExperimentList{{Name: "pod-delete"}, {Name: "node-cpu-hog"}}, &Selector{
+				Workloads: []Workload{{Kind: "Deployment", Names: "frontend"}},
+				Nodes:     []Node{{Names: "worker-1"}},
+			}),
+		},
+		"Test Negative-1": {
+			policy:         ChaosPolicySpec{AllowedExperiments: []string{"pod-delete"}},
+			engine:         newEngine([]ExperimentList{{Name: "pod-delete"}, {Name: "pod-cpu-hog"}}, nil),
+			expectedFields: []string{"spec.experiments[1].name"},
+		},
+		"Test Negative-2": {
+			policy: ChaosPolicySpec{AllowedImageRegistries: []string{"registry.example.com"}, AllowHostPID: true, AllowPrivileged: true},
+			engine: newEngine([]ExperimentList{
+				{Name: "node-cpu-hog"},
+				{Name: "pod-delete"},
+				{Name: "node-cpu-hog", Spec: ExperimentAttributes{Components: ExperimentComponents{ExperimentImage: "registry.example.com.evil.io/go-runner"}}},
+			}, nil),
+			expectedFields: []string{"spec.components.runner.image", "spec.experiments[1].name", "spec.experiments[2].spec.components.experimentImage"},
+		},
+		"Test Negative-3": {
+			policy:         ChaosPolicySpec{AllowedImageRegistries: []string{"registry.example.com", "docker.io/litmuschaos/chaos-runner"}},
+			engine:         newEngine([]ExperimentList{{Name: "node-cpu-hog"}}, nil),
+			expectedFields: []string{"spec.experiments[0].name", "spec.experiments[0].name"},
+		},
+		"Test Negative-4": {
+			policy: ChaosPolicySpec{AllowedImageRegistries: []string{"docker.io/litmuschaos", "registry.example.com"}},
+			engine: func() *ChaosEngine {
+				engine := newEngine([]ExperimentList{{Name: "pod-delete"}}, nil)
+				engine.Spec.Components.Sidecar = []Sidecar{
+					{Image: "registry.example.com/chaos/fluentd:1.16"},
+					{Image: "registry.example.com:5000/chaos/fluentd:1.16"},
+					{Image: "busybox:1.36"},
+				}
+				return engine
+			}(),
+			expectedFields: []string{"spec.components.sidecar[1].image", "spec.components.sidecar[2].image"},
+		},
+		"Test Negative-5": {
+			policy: ChaosPolicySpec{AllowedWorkloadKinds: []string{"deployment"}},
+			engine: newEngine([]ExperimentList{{Name: "pod-delete"}}, &Selector{
+				Workloads: []Workload{{Kind: "deployment", Names: "frontend"}, {Kind: "statefulset", Names: "db"}},
+				Pods:      []Pod{{Namespace: "shop", Names: "frontend-1"}},
+			}),
+			expectedFields: []string{"spec.selectors.workloads[1].kind", "spec.selectors.pods[0]"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &ChaosPolicy{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}, Spec: mock.policy}
+
+			violations := policy.Evaluate(mock.engine, experiments)
+			if len(violations) != len(mock.expectedFields) {
+				t.Fatalf("Test %q failed: expected %d violations, got %v", name, len(mock.expectedFields), violations)
+			}
+			for i, violation := range violations {
+				if violation.Field != mock.expectedFields[i] {
+					t.Fatalf("Test %q failed: expected violation of field %s, got %v", name, mock.expectedFields[i], violation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosPolicySpec defines the desired state of ChaosPolicy
+// A ChaosPolicy limits what the ChaosEngines of the selected namespaces may do. Every ChaosPolicy
+// selecting the namespace of a ChaosEngine applies to it, so the ChaosEngine must satisfy all of them
+type ChaosPolicySpec struct {
+	// NamespaceSelector selects the namespaces, e.g. of a team, whose ChaosEngines are limited by the policy,
+	// all the namespaces if not provided
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// AllowedExperiments are the names of the ChaosExperiments which may be run, any if not provided
+	AllowedExperiments []string `json:"allowedExperiments,omitempty"`
+	// AllowedImageRegistries are the registries, optionally followed by a repository path such as
+	// docker.io/litmuschaos, which the runner, sidecar and experiment images may come from, any if not provided
+	AllowedImageRegistries []string `json:"allowedImageRegistries,omitempty"`
+	// AllowHostPID allows the ChaosExperiments to run with the hostPID
+	AllowHostPID bool `json:"allowHostPID,omitempty"`
+	// AllowPrivileged allows the ChaosExperiments, and the source pods of their cmdProbes, to run privileged
+	AllowPrivileged bool `json:"allowPrivileged,omitempty"`
+	// AllowedWorkloadKinds are the kinds of the workloads which may be targeted, in lower case, where pod and
+	// node stand for the pod and node selectors, any if not provided
+	AllowedWorkloadKinds []string `json:"allowedWorkloadKinds,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +resource:path=chaospolicy
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+
+// ChaosPolicy is the Schema for the chaospolicies API
+type ChaosPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ChaosPolicySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChaosPolicyList contains a list of ChaosPolicy
+type ChaosPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosPolicy{}, &ChaosPolicyList{})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosScheduleSpec defines the desired state of ChaosSchedule
+// A ChaosSchedule creates a new ChaosEngine from its template on every tick of the cron schedule
+type ChaosScheduleSpec struct {
+	// Schedule is the cron expression, in the standard five field format, at which chaos is run
+	Schedule string `json:"schedule"`
+	// TimeZone is the IANA name of the time zone used to evaluate the schedule
+	// it defaults to the time zone of the chaos-operator
+	TimeZone string `json:"timeZone,omitempty"`
+	// StartingDeadlineSeconds is the deadline in seconds for starting a run if it misses its scheduled time
+	// runs missing the deadline are skipped
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// ConcurrencyPolicy specifies how to treat concurrent runs of the schedule
+	// it can be Allow, Forbid or Replace, default value is Forbid
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// Suspend tells the operator to suspend subsequent runs, it does not apply to already started runs
+	Suspend bool `json:"suspend,omitempty"`
+	// HistoryLimit is the number of finished ChaosEngines to retain, default value is 3
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+	// EngineTemplate is the ChaosEngine that is created on every run of the schedule
+	EngineTemplate ChaosEngineTemplateSpec `json:"engineTemplate"`
+}
+
+// ConcurrencyPolicy describes how the runs of a ChaosSchedule are handled
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow allows ChaosEngines of the same schedule to run concurrently
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+	// ConcurrencyPolicyForbid skips the new run if the previous one hasn't finished yet
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+	// ConcurrencyPolicyReplace aborts the currently running ChaosEngine and replaces it with a new one
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
+// ChaosEngineTemplateSpec describes the ChaosEngine created by a ChaosSchedule
+type ChaosEngineTemplateSpec struct {
+	// Labels and annotations copied to the created ChaosEngine
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec of the created ChaosEngine
+	Spec ChaosEngineSpec `json:"spec"`
+}
+
+// ChaosScheduleStatus defines the observed state of ChaosSchedule
+type ChaosScheduleStatus struct {
+	// Active contains the references of the ChaosEngines of this schedule which haven't finished yet
+	Active []corev1.ObjectReference `json:"active,omitempty"`
+	// LastScheduleTime is the last time a ChaosEngine was successfully scheduled
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+}
+
+// +genclient
+// +resource:path=chaosschedule
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ChaosSchedule is the Schema for the chaosschedules API
+type ChaosSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChaosScheduleSpec   `json:"spec,omitempty"`
+	Status ChaosScheduleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChaosScheduleList contains a list of ChaosSchedule
+type ChaosScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosSchedule{}, &ChaosScheduleList{})
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// blackoutWindowActiveReason is the reason of the Blocked condition of the chaosengines held back by a chaosblackout
+const blackoutWindowActiveReason = "BlackoutWindowActive"
+
+// maxBlackoutOccurrences bounds the occurrences of a window walked through while evaluating it,
+// as the occurrences of a window overlap if its duration is longer than its schedule interval
+const maxBlackoutOccurrences = 1000
+
+// activeBlackout describes the chaosblackout whose window is active for a chaosengine
+type activeBlackout struct {
+	name         string
+	end          time.Time
+	abortRunning bool
+}
+
+// getBlackoutWindow evaluates the windows of the chaosblackout at the given time. It returns the end of the
+// active window, which is zero if no window is active, along with the start of the next window
+func getBlackoutWindow(blackout *litmuschaosv1alpha1.ChaosBlackout, now time.Time) (time.Time, time.Time, error) {
+	location := time.Local
+	if blackout.Spec.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(blackout.Spec.TimeZone); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid timeZone %q of chaosblackout %s: %v", blackout.Spec.TimeZone, blackout.Name, err)
+		}
+	}
+
+	var end, nextStart time.Time
+	for i, window := range blackout.Spec.Windows {
+		schedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid schedule %q of window %d of chaosblackout %s: %v", window.Schedule, i, blackout.Name, err)
+		}
+		if window.Duration.Duration <= 0 {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid duration %s of window %d of chaosblackout %s", window.Duration.Duration, i, blackout.Name)
+		}
+
+		// the occurrences started within the last duration are active
+		start := schedule.Next(now.Add(-window.Duration.Duration).In(location))
+		for n := 0; n < maxBlackoutOccurrences && !start.IsZero() && !start.After(now); n++ {
+			if windowEnd := start.Add(window.Duration.Duration); windowEnd.After(end) {
+				end = windowEnd
+			}
+			start = schedule.Next(start)
+		}
+		if !start.IsZero() && start.After(now) && (nextStart.IsZero() || start.Before(nextStart)) {
+			nextStart = start
+		}
+	}
+	return end, nextStart, nil
+}
+
+// getActiveBlackout returns the chaosblackout whose window is active for the chaosengine, preferring the ones
+// aborting the running chaos, along with the start of the next window aborting the running chaos
+func (r *ChaosEngineReconciler) getActiveBlackout(engine *chaosTypes.EngineInfo) (*activeBlackout, time.Time, error) {
+	blackoutList := &litmuschaosv1alpha1.ChaosBlackoutList{}
+	if err := r.Client.List(context.TODO(), blackoutList); err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to list chaosblackouts, due to error: %v", err)
+	}
+	if len(blackoutList.Items) == 0 {
+		return nil, time.Time{}, nil
+	}
+
+	var namespace *corev1.Namespace
+	var active *activeBlackout
+	var nextAbort time.Time
+	now := time.Now()
+	for i := range blackoutList.Items {
+		blackout := &blackoutList.Items[i]
+		if blackout.Spec.NamespaceSelector != nil {
+			selector, err := v1.LabelSelectorAsSelector(blackout.Spec.NamespaceSelector)
+			if err != nil {
+				return nil, time.Time{}, fmt.Errorf("%w: invalid namespaceSelector of chaosblackout %s: %v", errInvalidBlackout, blackout.Name, err)
+			}
+			if namespace == nil {
+				namespace = &corev1.Namespace{}
+				if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: engine.Instance.Namespace}, namespace); err != nil {
+					return nil, time.Time{}, fmt.Errorf("unable to get namespace, due to error: %v", err)
+				}
+			}
+			if !selector.Matches(labels.Set(namespace.Labels)) {
+				continue
+			}
+		}
+
+		end, nextStart, err := getBlackoutWindow(blackout, now)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("%w: %v", errInvalidBlackout, err)
+		}
+		if blackout.Spec.AbortRunning && !nextStart.IsZero() && (nextAbort.IsZero() || nextStart.Before(nextAbort)) {
+			nextAbort = nextStart
+		}
+		if end.IsZero() {
+			continue
+		}
+		if active == nil || (blackout.Spec.AbortRunning && !active.abortRunning) || (blackout.Spec.AbortRunning == active.abortRunning && end.After(active.end)) {
+			active = &activeBlackout{name: blackout.Name, end: end, abortRunning: blackout.Spec.AbortRunning}
+		}
+	}
+	return active, nextAbort, nil
+}
+
+// blockEngineForBlackout holds the chaosengine in the blocked state while the window of the chaosblackout is active
+func (r *ChaosEngineReconciler) blockEngineForBlackout(engine *chaosTypes.EngineInfo, blackout *activeBlackout) (reconcile.Result, error) {
+	result, err := r.blockEngine(engine, blackoutWindowActiveReason, fmt.Errorf("chaos blackout %s is active until %s", blackout.name, blackout.end.Format(time.RFC3339)))
+	if err == nil && time.Until(blackout.end) < result.RequeueAfter {
+		result.RequeueAfter = time.Until(blackout.end) + time.Second
+	}
+	return result, err
+}
+
+// abortEngine force aborts the active chaosengine, as if its engineState was set to stop, recording the reason in its events
+func (r *ChaosEngineReconciler) abortEngine(engine *chaosTypes.EngineInfo, request reconcile.Request, reason, message string) (reconcile.Result, error) {
+	r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, reason, "Aborting the chaos, as the %s", message)
+	if err := r.updateEngineState(engine, litmuschaosv1alpha1.EngineStateStop); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
+		return reconcile.Result{}, err
+	}
+	return r.reconcileForDelete(engine, request)
+}
+
+// getEnginesForBlackout returns the requests for all the chaosengines, as the chaosblackout may apply to any of them
+func (r *ChaosEngineReconciler) getEnginesForBlackout(obj client.Object) []reconcile.Request {
+	return r.getAllEngineRequests()
+}
+
+// getAllEngineRequests returns the requests for all the chaosengines of the cluster
+func (r *ChaosEngineReconciler) getAllEngineRequests() []reconcile.Request {
+	engineList := &litmuschaosv1alpha1.ChaosEngineList{}
+	if err := r.Client.List(context.TODO(), engineList); err != nil {
+		chaosTypes.Log.Error(err, "unable to list chaosengines")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, engine := range engineList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: engine.Name, Namespace: engine.Namespace}})
+	}
+	return requests
+}
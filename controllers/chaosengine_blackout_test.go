@@ -0,0 +1,190 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetBlackoutWindow(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+	newBlackout := func(timeZone string, windows ...v1alpha1.BlackoutWindow) *v1alpha1.ChaosBlackout {
+		return &v1alpha1.ChaosBlackout{
+			ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+			Spec:       v1alpha1.ChaosBlackoutSpec{Windows: windows, TimeZone: timeZone},
+		}
+	}
+	window := func(schedule string, duration time.Duration) v1alpha1.BlackoutWindow {
+		return v1alpha1.BlackoutWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
+
+	tests := map[string]struct {
+		blackout          *v1alpha1.ChaosBlackout
+		expectedEnd       time.Time
+		expectedNextStart time.Time
+		isErr             bool
+	}{
+		"Test Positive-1": {
+			// the window started at 10:00 and lasts till 12:00
+			blackout:          newBlackout("UTC", window("0 10 * * *", 2*time.Hour)),
+			expectedEnd:       time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2024, time.March, 16, 10, 0, 0, 0, time.UTC),
+		},
+		"Test Positive-2": {
+			// the window ended at 10:15
+			blackout:          newBlackout("UTC", window("0 10 * * *", 15*time.Minute)),
+			expectedNextStart: time.Date(2024, time.March, 16, 10, 0, 0, 0, time.UTC),
+		},
+		"Test Positive-3": {
+			// 10:00 in Kolkata is 04:30 in UTC, so the window starts at 09:00 in Kolkata, i.e. 03:30 in UTC
+			blackout:          newBlackout("Asia/Kolkata", window("0 9 * * *", 8*time.Hour)),
+			expectedEnd:       time.Date(2024, time.March, 15, 11, 30, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2024, time.March, 16, 3, 30, 0, 0, time.UTC),
+		},
+		"Test Positive-4": {
+			// the overlapping occurrences extend the window
+			blackout:          newBlackout("UTC", window("0 * * * *", 90*time.Minute), window("0 0 * * 0", time.Hour)),
+			expectedEnd:       time.Date(2024, time.March, 15, 11, 30, 0, 0, time.UTC),
+			expectedNextStart: time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC),
+		},
+		"Test Negative-1": {
+			blackout: newBlackout("UTC", window("every day", time.Hour)),
+			isErr:    true,
+		},
+		"Test Negative-2": {
+			blackout: newBlackout("Mars/Olympus", window("0 10 * * *", time.Hour)),
+			isErr:    true,
+		},
+		"Test Negative-3": {
+			blackout: newBlackout("UTC", window("0 10 * * *", 0)),
+			isErr:    true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			end, nextStart, err := getBlackoutWindow(mock.blackout, now)
+			if mock.isErr {
+				if err == nil {
+					t.Fatalf("Test %q failed: expected error not to be nil", name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.True(t, mock.expectedEnd.Equal(end), "expected end %v, received %v", mock.expectedEnd, end)
+			require.True(t, mock.expectedNextStart.Equal(nextStart), "expected next start %v, received %v", mock.expectedNextStart, nextStart)
+		})
+	}
+}
+
+func TestGetActiveBlackout(t *testing.T) {
+	// a window active all the time, starting every minute and lasting an hour
+	activeWindow := []v1alpha1.BlackoutWindow{{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}}}
+	tests := map[string]struct {
+		blackouts    []v1alpha1.ChaosBlackout
+		isActive     bool
+		abortRunning bool
+		isErr        bool
+	}{
+		"Test Positive-1": {
+			blackouts: []v1alpha1.ChaosBlackout{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+					Spec:       v1alpha1.ChaosBlackoutSpec{Windows: activeWindow},
+				},
+			},
+			isActive: true,
+		},
+		"Test Positive-2": {
+			blackouts: []v1alpha1.ChaosBlackout{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+					Spec:       v1alpha1.ChaosBlackoutSpec{Windows: activeWindow},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "release"},
+					Spec: v1alpha1.ChaosBlackoutSpec{
+						Windows:           activeWindow,
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						AbortRunning:      true,
+					},
+				},
+			},
+			isActive:     true,
+			abortRunning: true,
+		},
+		"Test Negative-1": {
+			blackouts: []v1alpha1.ChaosBlackout{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "staging"},
+					Spec: v1alpha1.ChaosBlackoutSpec{
+						Windows:           activeWindow,
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}},
+					},
+				},
+			},
+		},
+		"Test Negative-2": {
+			blackouts: []v1alpha1.ChaosBlackout{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+					Spec:       v1alpha1.ChaosBlackoutSpec{Windows: activeWindow, TimeZone: "Mars/Olympus"},
+				},
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop", Labels: map[string]string{"env": "prod"}}}
+			if err := r.Client.Create(context.TODO(), namespace); err != nil {
+				t.Fatalf("Test %q failed: unable to create namespace: %v", name, err)
+			}
+			for i := range mock.blackouts {
+				if err := r.Client.Create(context.TODO(), &mock.blackouts[i]); err != nil {
+					t.Fatalf("Test %q failed: unable to create chaosblackout: %v", name, err)
+				}
+			}
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"}},
+			}
+
+			blackout, _, err := r.getActiveBlackout(engine)
+			if mock.isErr {
+				if !errors.Is(err, errInvalidBlackout) {
+					t.Fatalf("Test %q failed: expected invalid chaosblackout error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Equal(t, mock.isActive, blackout != nil)
+			if blackout != nil {
+				require.Equal(t, mock.abortRunning, blackout.abortRunning)
+			}
+		})
+	}
+}
@@ -18,9 +18,11 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,38 +30,84 @@ import (
 	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
 	"github.com/litmuschaos/chaos-operator/pkg/analytics"
 	dynamicclientset "github.com/litmuschaos/chaos-operator/pkg/client/dynamic"
+	"github.com/litmuschaos/chaos-operator/pkg/metrics"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
 	"github.com/litmuschaos/chaos-operator/pkg/utils"
-	"github.com/litmuschaos/chaos-operator/pkg/utils/retry"
 	"github.com/litmuschaos/elves/kubernetes/container"
 	"github.com/litmuschaos/elves/kubernetes/pod"
+	volume "github.com/litmuschaos/elves/kubernetes/volume/v1alpha1"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const finalizer = "chaosengine.litmuschaos.io/finalizer"
 
+var (
+	// errExperimentNotFound is returned if any of the experiments listed in the chaosengine doesn't exist
+	errExperimentNotFound = errors.New("unable to find chaosexperiments")
+	// errInvalidSelector is returned if the selectors of the chaosengine can't be resolved into targets
+	errInvalidSelector = errors.New("invalid selectors")
+	// errBlastRadiusExceeded is returned if the resolved targets exceed the blast-radius limits of the chaosengine
+	errBlastRadiusExceeded = errors.New("blast radius exceeded")
+	// errDisruptionBudgetExceeded is returned if the disruption of the targets would break their poddisruptionbudgets
+	errDisruptionBudgetExceeded = errors.New("poddisruptionbudget exceeded")
+	// errTargetLocked is returned if any of the targets of the chaosengine is locked by another chaosengine
+	errTargetLocked = errors.New("target locked")
+	// errNamespaceNotOptedIn is returned when a namespace of the chaos targets isn't opted in for chaos
+	errNamespaceNotOptedIn = errors.New("namespace not opted in")
+	// errPolicyViolated is returned when the chaosengine violates the chaospolicies applying to it
+	errPolicyViolated = errors.New("chaospolicy violated")
+	// errInvalidBlackout is returned when a chaosblackout can't be evaluated
+	errInvalidBlackout = errors.New("invalid chaosblackout")
+)
+
+// requeueInterval is the interval after which the chaosengine is requeued
+// while waiting for the termination of chaos pods
+const requeueInterval = 5 * time.Second
+
 // ChaosEngineReconciler reconciles a ChaosEngine object
 type ChaosEngineReconciler struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client.Client
+	// APIReader reads the objects which aren't cached by the manager, such as the nodes and workloads,
+	// along with the objects which must not be stale, such as the chaosengines admitted from the queue,
+	// directly from the apiserver
+	APIReader client.Reader
 	// Used for serializing and deserializing API objects(group, version, and kind)
 	Scheme *runtime.Scheme
 	// recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
 	Recorder record.EventRecorder
+	// OperatorNamespace is the namespace of the operator, holding the kill switch configmap
+	OperatorNamespace string
+	// killSwitchReader reads the kill switch configmap out of the cache holding only the kill switch configmap
+	killSwitchReader client.Reader
+	// MaxActiveEngines is the maximum number of active chaosengines in the cluster, unlimited if 0
+	MaxActiveEngines int
+	// MaxActiveEnginesPerNamespace is the maximum number of active chaosengines in each namespace, unless
+	// overridden by the annotation of the namespace, unlimited if 0
+	MaxActiveEnginesPerNamespace int
+	// RequireNamespaceOptIn requires the namespaces of the chaos targets to be opted in through the chaos-allowed label
+	RequireNamespaceOptIn bool
+	// RunnerImage is the runner image of the chaosengines which don't set it, falling back to the default runner image if empty
+	RunnerImage string
 }
 
 // reconcileEngine contains details of reconcileEngine
@@ -77,6 +125,18 @@ type podEngineRunner struct {
 //+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosengines,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosengines/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosengines/finalizers,verbs=update
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosresults,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosblackouts,verbs=get;list;watch
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaospolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=replicationcontrollers,verbs=get;list
+//+kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;replicasets;statefulsets,verbs=get;list
+//+kubebuilder:rbac:groups=batch,resources=jobs;cronjobs,verbs=get;list
+//+kubebuilder:rbac:groups=apps.openshift.io,resources=deploymentconfigs,verbs=get;list
+//+kubebuilder:rbac:groups=argoproj.io,resources=rollouts,verbs=get;list
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list
+//+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update;delete;deletecollection
 
 // Reconcile reads that state of the cluster for a ChaosEngine object and makes changes based on the state read
 // and what is in the ChaosEngine.Spec
@@ -102,6 +162,17 @@ func (r *ChaosEngineReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return r.reconcileForDelete(engine, request)
 	}
 
+	// Halt the chaos while the kill switch is engaged
+	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && engine.Instance.Status.EngineStatus != litmuschaosv1alpha1.EngineStatusStopping {
+		isEngaged, err := r.isKillSwitchEngaged()
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if isEngaged {
+			return r.reconcileForKillSwitch(engine, request)
+		}
+	}
+
 	// Start the reconcile by setting default values into ChaosEngine
 	if requeue, err := r.initEngine(engine); err != nil {
 		if requeue {
@@ -111,7 +182,7 @@ func (r *ChaosEngineReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 	}
 
 	// Handling of normal execution of ChaosEngine
-	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
+	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && (engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized || isEngineHeld(engine.Instance.Status.EngineStatus)) {
 		return r.reconcileForCreationAndRunning(engine, *reqLogger)
 	}
 
@@ -120,13 +191,18 @@ func (r *ChaosEngineReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return r.reconcileForComplete(engine, request)
 	}
 
+	// Handling the termination of chaos pods for an aborted ChaosEngine
+	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopping {
+		return r.reconcileForDelete(engine, request)
+	}
+
 	// Handling forceful Abort of ChaosEngine
-	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateStop && engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
+	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateStop && (engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized || isEngineHeld(engine.Instance.Status.EngineStatus)) {
 		return r.reconcileForDelete(engine, request)
 	}
 
-	// Handling restarting of ChaosEngine post Abort
-	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && (engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopped) {
+	// Handling restarting of ChaosEngine post Abort or Error
+	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && (engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopped || engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusError) {
 		return r.reconcileForRestartAfterAbort(engine, request)
 	}
 
@@ -143,16 +219,86 @@ func getChaosRunnerENV(engine *chaosTypes.EngineInfo, ClientUUID string) []corev
 
 	var envDetails utils.ENVDetails
 	envDetails.SetEnv("CHAOSENGINE", engine.Instance.Name).
-		SetEnv("TARGETS", engine.Targets).
+		SetEnv("TARGETS", getTargetsENV(engine.Targets)).
 		SetEnv("EXPERIMENT_LIST", fmt.Sprint(strings.Join(engine.AppExperiments, ","))).
 		SetEnv("CHAOS_SVC_ACC", engine.Instance.Spec.ChaosServiceAccount).
 		SetEnv("AUXILIARY_APPINFO", engine.Instance.Spec.AuxiliaryAppInfo).
 		SetEnv("CLIENT_UUID", ClientUUID).
-		SetEnv("CHAOS_NAMESPACE", engine.Instance.Namespace)
+		SetEnv("CHAOS_NAMESPACE", engine.Instance.Namespace).
+		SetEnv("CHAOS_UID", string(engine.Instance.UID)).
+		SetEnv("RUN_ID", engine.RunID).
+		SetEnv("DEFAULT_HEALTH_CHECK", strconv.FormatBool(engine.Instance.Spec.DefaultHealthCheck)).
+		SetEnv("RUN_CONTEXT_PATH", chaosTypes.RunContextMountPath+"/"+chaosTypes.RunContextFileName)
+
+	if engine.Instance.Spec.TerminationGracePeriodSeconds != 0 {
+		envDetails.SetEnv("TERMINATION_GRACE_PERIOD_SECONDS", strconv.FormatInt(engine.Instance.Spec.TerminationGracePeriodSeconds, 10))
+	}
+	if engine.Instance.Spec.MaxTargetPercentage > 0 {
+		envDetails.SetEnv("MAX_TARGET_PERCENTAGE", strconv.Itoa(engine.Instance.Spec.MaxTargetPercentage))
+	}
+	if engine.Instance.Spec.MaxTargetCount > 0 {
+		envDetails.SetEnv("MAX_TARGET_COUNT", strconv.Itoa(engine.Instance.Spec.MaxTargetCount))
+	}
 
 	return envDetails.ENV
 }
 
+// getRunContextName returns the name of the configmap holding the run context of the chaosengine
+func getRunContextName(cr *litmuschaosv1alpha1.ChaosEngine) string {
+	return cr.Name + "-run-context"
+}
+
+// getRunContext returns the run context of the chaosengine, passed to the chaos-runner
+func getRunContext(engine *chaosTypes.EngineInfo) chaosTypes.RunContext {
+	return chaosTypes.RunContext{
+		RunID:                         engine.RunID,
+		EngineName:                    engine.Instance.Name,
+		EngineNamespace:               engine.Instance.Namespace,
+		EngineUID:                     string(engine.Instance.UID),
+		EngineLabels:                  engine.Instance.Labels,
+		ChaosServiceAccount:           engine.Instance.Spec.ChaosServiceAccount,
+		DefaultHealthCheck:            engine.Instance.Spec.DefaultHealthCheck,
+		TerminationGracePeriodSeconds: engine.Instance.Spec.TerminationGracePeriodSeconds,
+		ActiveDeadlineSeconds:         engine.Instance.Spec.ActiveDeadlineSeconds,
+		JobCleanUpPolicy:              engine.Instance.Spec.JobCleanUpPolicy,
+		AuxiliaryAppInfo:              engine.Instance.Spec.AuxiliaryAppInfo,
+		MaxTargetPercentage:           engine.Instance.Spec.MaxTargetPercentage,
+		MaxTargetCount:                engine.Instance.Spec.MaxTargetCount,
+		Targets:                       engine.Targets,
+		Experiments:                   engine.AppExperiments,
+	}
+}
+
+// applyRunContext creates or updates the configmap holding the run context of the chaosengine,
+// which is mounted inside the chaos-runner
+func (r *ChaosEngineReconciler) applyRunContext(engine *chaosTypes.EngineInfo) error {
+	runContext, err := json.Marshal(getRunContext(engine))
+	if err != nil {
+		return fmt.Errorf("unable to marshal run context, due to error: %v", err)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      getRunContextName(engine.Instance),
+			Namespace: engine.Instance.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(context.TODO(), r.Client, configMap, func() error {
+		configMap.Labels = map[string]string{
+			"chaosUID":                    string(engine.Instance.UID),
+			"app.kubernetes.io/component": "chaos-run-context",
+			"app.kubernetes.io/part-of":   "litmus",
+		}
+		configMap.Data = map[string]string{
+			chaosTypes.RunContextFileName: string(runContext),
+		}
+		return controllerutil.SetControllerReference(engine.Instance, configMap, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("unable to apply run context configmap, due to error: %v", err)
+	}
+	return nil
+}
+
 // getChaosRunnerLabels return the labels required for chaos-runner
 func getChaosRunnerLabels(cr *litmuschaosv1alpha1.ChaosEngine) map[string]string {
 	labels := map[string]string{
@@ -169,12 +315,26 @@ func getChaosRunnerLabels(cr *litmuschaosv1alpha1.ChaosEngine) map[string]string
 
 // newGoRunnerPodForCR defines a new go-based Runner Pod
 func (r *ChaosEngineReconciler) newGoRunnerPodForCR(engine *chaosTypes.EngineInfo) (*corev1.Pod, error) {
-	var experiment litmuschaosv1alpha1.ChaosExperiment
-	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: engine.Instance.Spec.Experiments[0].Name, Namespace: engine.Instance.Namespace}, &experiment); err != nil {
+	experiments, missing, err := r.getChaosExperiments(engine)
+	if err != nil {
 		return nil, err
 	}
+	if len(missing) != 0 {
+		return nil, fmt.Errorf("unable to find chaosexperiments %s in namespace %s", strings.Join(missing, ","), engine.Instance.Namespace)
+	}
+
+	var securityContexts []litmuschaosv1alpha1.SecurityContext
+	for _, experiment := range experiments {
+		securityContexts = append(securityContexts, experiment.Spec.Definition.SecurityContext)
+	}
+	securityContext := utils.MergeSecurityContexts(securityContexts)
 
-	engine.VolumeOpts.VolumeOperations(engine.Instance.Spec.Components.Runner.ConfigMaps, engine.Instance.Spec.Components.Runner.Secrets)
+	configMaps := append([]litmuschaosv1alpha1.ConfigMap{}, engine.Instance.Spec.Components.Runner.ConfigMaps...)
+	configMaps = append(configMaps, litmuschaosv1alpha1.ConfigMap{
+		Name:      getRunContextName(engine.Instance),
+		MountPath: chaosTypes.RunContextMountPath,
+	})
+	engine.VolumeOpts.VolumeOperations(configMaps, engine.Instance.Spec.Components.Runner.Secrets)
 
 	containerForRunner := container.NewBuilder().
 		WithEnvsNew(getChaosRunnerENV(engine, analytics.ClientUUID)).
@@ -202,8 +362,8 @@ func (r *ChaosEngineReconciler) newGoRunnerPodForCR(engine *chaosTypes.EngineInf
 		containerForRunner.WithResourceRequirements(engine.Instance.Spec.Components.Runner.Resources)
 	}
 
-	if !reflect.DeepEqual(experiment.Spec.Definition.SecurityContext.ContainerSecurityContext, corev1.SecurityContext{}) {
-		containerForRunner.WithSecurityContext(experiment.Spec.Definition.SecurityContext.ContainerSecurityContext)
+	if !reflect.DeepEqual(securityContext.ContainerSecurityContext, corev1.SecurityContext{}) {
+		containerForRunner.WithSecurityContext(securityContext.ContainerSecurityContext)
 	}
 
 	podForRunner := pod.NewBuilder().
@@ -212,9 +372,14 @@ func (r *ChaosEngineReconciler) newGoRunnerPodForCR(engine *chaosTypes.EngineInf
 		WithAnnotations(engine.Instance.Spec.Components.Runner.RunnerAnnotation).
 		WithLabels(getChaosRunnerLabels(engine.Instance)).
 		WithServiceAccountName(engine.Instance.Spec.ChaosServiceAccount).
-		WithRestartPolicy("OnFailure").
+		WithRestartPolicy(corev1.RestartPolicyNever).
 		WithContainerBuilder(containerForRunner)
 
+	sidecarContainers, sidecarVolumes := getSidecarDetails(engine)
+	for _, sidecar := range sidecarContainers {
+		podForRunner.WithContainerBuilder(sidecar)
+	}
+
 	if engine.Instance.Spec.Components.Runner.Tolerations != nil {
 		podForRunner.WithTolerations(engine.Instance.Spec.Components.Runner.Tolerations...)
 	}
@@ -227,12 +392,16 @@ func (r *ChaosEngineReconciler) newGoRunnerPodForCR(engine *chaosTypes.EngineInf
 		podForRunner.WithVolumeBuilders(engine.VolumeOpts.VolumeBuilders)
 	}
 
+	if len(sidecarVolumes) != 0 {
+		podForRunner.WithVolumeBuilders(sidecarVolumes)
+	}
+
 	if engine.Instance.Spec.Components.Runner.ImagePullSecrets != nil {
 		podForRunner.WithImagePullSecrets(engine.Instance.Spec.Components.Runner.ImagePullSecrets)
 	}
 
-	if !reflect.DeepEqual(experiment.Spec.Definition.SecurityContext.PodSecurityContext, corev1.PodSecurityContext{}) {
-		podForRunner.WithSecurityContext(experiment.Spec.Definition.SecurityContext.PodSecurityContext)
+	if !reflect.DeepEqual(securityContext.PodSecurityContext, corev1.PodSecurityContext{}) {
+		podForRunner.WithSecurityContext(securityContext.PodSecurityContext)
 	}
 
 	runnerPod, err := podForRunner.Build()
@@ -245,6 +414,69 @@ func (r *ChaosEngineReconciler) newGoRunnerPodForCR(engine *chaosTypes.EngineInf
 	return runnerPod, nil
 }
 
+// getSidecarDetails builds the sidecar containers defined in the chaosengine along with the volumes for their
+// secrets, reusing the volumes of the secrets which are already part of the runner pod. The volumes are named
+// after their configmaps and secrets, so a sidecar secret named like a volume of another configmap or secret
+// gets its own volume name
+func getSidecarDetails(engine *chaosTypes.EngineInfo) ([]*container.Builder, []*volume.Builder) {
+	var containers []*container.Builder
+	var volumeBuilders []*volume.Builder
+
+	// volumeNames contains the names of the volumes of the runner pod, while secretVolumes
+	// maps the secrets mounted inside the runner pod to the names of their volumes
+	volumeNames := make(map[string]bool)
+	secretVolumes := make(map[string]string)
+	for _, cm := range engine.Instance.Spec.Components.Runner.ConfigMaps {
+		volumeNames[cm.Name] = true
+	}
+	for _, secret := range engine.Instance.Spec.Components.Runner.Secrets {
+		volumeNames[secret.Name] = true
+		secretVolumes[secret.Name] = secret.Name
+	}
+
+	for i, sidecar := range engine.Instance.Spec.Components.Sidecar {
+		var volumeOpts utils.VolumeOpts
+		volumeOpts.VolumeOperations(nil, sidecar.Secrets)
+
+		for j, secret := range sidecar.Secrets {
+			name, ok := secretVolumes[secret.Name]
+			if !ok {
+				name = secret.Name
+				if volumeNames[name] {
+					name = "sidecar-secret-" + secret.Name
+				}
+				volumeNames[name] = true
+				secretVolumes[secret.Name] = name
+				volumeBuilders = append(volumeBuilders, volumeOpts.VolumeBuilders[j].WithName(name))
+			}
+			volumeOpts.VolumeMounts[j].Name = name
+		}
+
+		containerForSidecar := container.NewBuilder().
+			WithName(fmt.Sprintf("sidecar-%d", i)).
+			WithImage(sidecar.Image).
+			WithImagePullPolicy(corev1.PullIfNotPresent)
+
+		if sidecar.ImagePullPolicy != "" {
+			containerForSidecar.WithImagePullPolicy(sidecar.ImagePullPolicy)
+		}
+
+		if len(sidecar.ENV) != 0 {
+			containerForSidecar.WithEnvsNew(sidecar.ENV)
+		}
+
+		if len(sidecar.EnvFrom) != 0 {
+			containerForSidecar.WithEnvsFrom(sidecar.EnvFrom)
+		}
+
+		if len(volumeOpts.VolumeMounts) != 0 {
+			containerForSidecar.WithVolumeMountsNew(volumeOpts.VolumeMounts)
+		}
+		containers = append(containers, containerForSidecar)
+	}
+	return containers, volumeBuilders
+}
+
 // engineRunnerPod to Check if the engineRunner pod already exists, else create
 func engineRunnerPod(runnerPod *podEngineRunner) error {
 	if err := runnerPod.r.Client.Get(context.TODO(), types.NamespacedName{Name: runnerPod.engineRunner.Name, Namespace: runnerPod.engineRunner.Namespace}, runnerPod.pod); err != nil && k8serrors.IsNotFound(err) {
@@ -306,20 +538,9 @@ func (r *ChaosEngineReconciler) checkEngineRunnerPod(engine *chaosTypes.EngineIn
 	return engineRunnerPod(runnerPod)
 }
 
-// setChaosResourceImage take the runner image from engine spec
-// if it is not there then it will take from chaos-operator env
-// at last if it is not able to find image in engine spec and operator env then it will take default images
-func setChaosResourceImage(engine *chaosTypes.EngineInfo) {
-	ChaosRunnerImage := os.Getenv("CHAOS_RUNNER_IMAGE")
-
-	if engine.Instance.Spec.Components.Runner.Image == "" && ChaosRunnerImage == "" {
-		engine.Instance.Spec.Components.Runner.Image = chaosTypes.DefaultChaosRunnerImage
-	} else if engine.Instance.Spec.Components.Runner.Image == "" {
-		engine.Instance.Spec.Components.Runner.Image = ChaosRunnerImage
-	}
-}
-
-// reconcileForDelete reconciles for deletion/force deletion of Chaos Engine
+// reconcileForDelete reconciles for deletion/force deletion of Chaos Engine.
+// The chaos pods are force deleted first and the chaosengine is moved to stopping status, which is
+// requeued until the chaos pods are terminated. The chaosengine is stopped after that.
 func (r *ChaosEngineReconciler) reconcileForDelete(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
 	patch := client.MergeFrom(engine.Instance.DeepCopy())
 
@@ -335,13 +556,34 @@ func (r *ChaosEngineReconciler) reconcileForDelete(engine *chaosTypes.EngineInfo
 		return reconcile.Result{}, err
 	}
 
+	isStopping := engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopping
+	// the chaosengines stopped for an invalid spec are already recorded as failed, rather than aborted
+	isAborted := engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized && !isEngineFailed(engine.Instance)
+	if !isStopping {
+		updateConditionsForStop(engine)
+	}
+
 	if len(chaosPodList.Items) != 0 {
-		chaosTypes.Log.Info("Performing a force delete of chaos experiment pods", "chaosengine", engine.Instance.Name)
-		err := r.forceRemoveChaosResources(engine, request)
-		if err != nil {
-			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos experiment pods")
-			return reconcile.Result{}, err
+		if !isStopping {
+			chaosTypes.Log.Info("Performing a force delete of chaos experiment pods", "chaosengine", engine.Instance.Name)
+			err := r.forceRemoveChaosResources(engine, request)
+			if err != nil {
+				r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos experiment pods")
+				return reconcile.Result{}, err
+			}
+
+			engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusStopping
+			if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+				r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
+				return reconcile.Result{}, fmt.Errorf("unable to patch status of chaosEngine Resource, due to error: %v", err)
+			}
+			if isAborted {
+				metrics.RecordEngineAborted(engine.Instance.Namespace, engine.Instance.Name, getRunDuration(engine.Instance))
+			}
 		}
+
+		chaosTypes.Log.Info("Waiting for the termination of chaos pods", "chaosengine", engine.Instance.Name, "pods", len(chaosPodList.Items))
+		return reconcile.Result{RequeueAfter: requeueInterval}, nil
 	}
 
 	// update the chaos status in result for abort cases
@@ -361,16 +603,39 @@ func (r *ChaosEngineReconciler) reconcileForDelete(engine *chaosTypes.EngineInfo
 		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
 		return reconcile.Result{}, fmt.Errorf("unable to remove finalizer from chaosEngine Resource, due to error: %v", err)
 	}
+	if isAborted {
+		metrics.RecordEngineAborted(engine.Instance.Namespace, engine.Instance.Name, getRunDuration(engine.Instance))
+	}
+	r.releaseTargetLocks(engine)
 
-	// we are repeating this condition/check here as we want the events for 'ChaosEngineStopped'
-	// generated only after successful finalizer removal from the chaosengine resource
-	if len(chaosPodList.Items) != 0 {
+	// we want the events for 'ChaosEngineStopped' generated only after
+	// successful finalizer removal from the chaosengine resource
+	if isStopping {
 		r.Recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineStopped", "Chaos resources deleted successfully")
 	}
 
 	return reconcile.Result{}, nil
 }
 
+// isEngineFailed checks if the Failed condition of the ChaosEngine is true
+func isEngineFailed(engine *litmuschaosv1alpha1.ChaosEngine) bool {
+	return meta.IsStatusConditionTrue(engine.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionFailed)
+}
+
+// updateConditionsForStop updates the conditions of the ChaosEngine, which is being stopped.
+// The failed ChaosEngines aren't marked as aborted, as they are stopped for their failure
+func updateConditionsForStop(engine *chaosTypes.EngineInfo) {
+	if engine.Instance.Status.EngineStatus != litmuschaosv1alpha1.EngineStatusCompleted && !isEngineFailed(engine.Instance) && !meta.IsStatusConditionTrue(engine.Instance.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionAborted) {
+		setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionAborted, v1.ConditionTrue, "ChaosEngineStopped", "ChaosEngine is stopped before completion")
+	}
+	setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionRunning, v1.ConditionFalse, "ChaosEngineStopped", "ChaosEngine is stopped")
+	for _, conditionType := range []string{litmuschaosv1alpha1.ChaosEngineConditionBlocked, litmuschaosv1alpha1.ChaosEngineConditionQueued} {
+		if meta.IsStatusConditionTrue(engine.Instance.Status.Conditions, conditionType) {
+			setEngineCondition(engine.Instance, conditionType, v1.ConditionFalse, "ChaosEngineStopped", "ChaosEngine is stopped")
+		}
+	}
+}
+
 // forceRemoveChaosResources force removes all chaos-related pods
 func (r *ChaosEngineReconciler) forceRemoveChaosResources(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
 	optsDelete := []client.DeleteAllOfOption{client.InNamespace(request.NamespacedName.Namespace), client.MatchingLabels{"chaosUID": string(engine.Instance.UID)}, client.PropagationPolicy(v1.DeletePropagationBackground)}
@@ -400,6 +665,35 @@ func (r *ChaosEngineReconciler) forceRemoveChaosResources(engine *chaosTypes.Eng
 	return nil
 }
 
+// setEngineCondition records the given condition inside the chaosengine status along with the observed generation
+func setEngineCondition(instance *litmuschaosv1alpha1.ChaosEngine, conditionType string, status v1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: instance.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	instance.Status.ObservedGeneration = instance.Generation
+}
+
+// patchEngineCondition patches the chaosengine with the given condition, if it is not already present
+func (r *ChaosEngineReconciler) patchEngineCondition(engine *chaosTypes.EngineInfo, conditionType string, status v1.ConditionStatus, reason, message string) error {
+	condition := meta.FindStatusCondition(engine.Instance.Status.Conditions, conditionType)
+	if condition != nil && condition.Status == status && condition.Reason == reason && condition.ObservedGeneration == engine.Instance.Generation {
+		return nil
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	setEngineCondition(engine.Instance, conditionType, status, reason, message)
+
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch conditions of chaosEngine Resource, due to error: %v", err)
+	}
+
+	return nil
+}
+
 // updateEngineState updates Chaos Engine Status with given State
 func (r *ChaosEngineReconciler) updateEngineState(engine *chaosTypes.EngineInfo, state litmuschaosv1alpha1.EngineState) error {
 	patch := client.MergeFrom(engine.Instance.DeepCopy())
@@ -422,7 +716,8 @@ func (r *ChaosEngineReconciler) checkRunnerContainerCompletedStatus(engine *chao
 		return isCompleted, err
 	}
 
-	if runnerPod.Status.Phase == corev1.PodRunning || runnerPod.Status.Phase == corev1.PodSucceeded {
+	// the pod fails once its sidecars are terminated after the chaos-runner has completed
+	if runnerPod.Status.Phase == corev1.PodRunning || runnerPod.Status.Phase == corev1.PodSucceeded || runnerPod.Status.Phase == corev1.PodFailed {
 		for _, container := range runnerPod.Status.ContainerStatuses {
 			if container.Name == "chaos-runner" && container.State.Terminated != nil {
 				if container.State.Terminated.Reason == "Completed" {
@@ -435,6 +730,58 @@ func (r *ChaosEngineReconciler) checkRunnerContainerCompletedStatus(engine *chao
 	return isCompleted, nil
 }
 
+// getRunnerFailureReason returns the reason of failure of the chaos-runner container, if any.
+// It returns an empty string if the runner is healthy or has completed successfully, even if the
+// pod has failed afterwards, upon the termination of its sidecars
+func getRunnerFailureReason(runnerPod *corev1.Pod) string {
+	for _, container := range runnerPod.Status.ContainerStatuses {
+		if container.Name != "chaos-runner" {
+			continue
+		}
+		if container.State.Terminated != nil && container.State.Terminated.ExitCode == 0 && container.State.Terminated.Reason == "Completed" {
+			return ""
+		}
+		if container.State.Waiting != nil {
+			switch container.State.Waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "CrashLoopBackOff", "CreateContainerConfigError", "CreateContainerError":
+				return fmt.Sprintf("%s: %s", container.State.Waiting.Reason, container.State.Waiting.Message)
+			}
+		}
+		if container.State.Terminated != nil && (container.State.Terminated.ExitCode != 0 || container.State.Terminated.Reason != "Completed") {
+			return fmt.Sprintf("%s: exit code %d", container.State.Terminated.Reason, container.State.Terminated.ExitCode)
+		}
+	}
+
+	if runnerPod.Status.Phase == corev1.PodFailed {
+		return fmt.Sprintf("%s: %s", runnerPod.Status.Reason, runnerPod.Status.Message)
+	}
+
+	return ""
+}
+
+// removeChaosResourcesForError removes the chaos resources created by the chaos-runner, retaining
+// the runner pod for debugging the failure
+func (r *ChaosEngineReconciler) removeChaosResourcesForError(engine *chaosTypes.EngineInfo) error {
+	selector, err := labels.Parse(fmt.Sprintf("chaosUID=%s,app.kubernetes.io/component!=chaos-runner", engine.Instance.UID))
+	if err != nil {
+		return err
+	}
+
+	optsDelete := []client.DeleteAllOfOption{client.InNamespace(engine.Instance.Namespace), client.MatchingLabelsSelector{Selector: selector}, client.PropagationPolicy(v1.DeletePropagationBackground)}
+	if engine.Instance.Spec.TerminationGracePeriodSeconds != 0 {
+		optsDelete = append(optsDelete, client.GracePeriodSeconds(engine.Instance.Spec.TerminationGracePeriodSeconds))
+	}
+
+	if err := r.Client.DeleteAllOf(context.TODO(), &batchv1.Job{}, optsDelete...); err != nil {
+		return fmt.Errorf("unable to delete chaos jobs, due to error: %v", err)
+	}
+	if err := r.Client.DeleteAllOf(context.TODO(), &corev1.Pod{}, optsDelete...); err != nil {
+		return fmt.Errorf("unable to delete chaos pods, due to error: %v", err)
+	}
+
+	return nil
+}
+
 // gracefullyRemoveDefaultChaosResources removes all chaos-resources gracefully
 func (r *ChaosEngineReconciler) gracefullyRemoveDefaultChaosResources(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
 	if engine.Instance.Spec.JobCleanUpPolicy == litmuschaosv1alpha1.CleanUpPolicyDelete {
@@ -466,13 +813,76 @@ func (r *ChaosEngineReconciler) gracefullyRemoveChaosPods(engine *chaosTypes.Eng
 	return nil
 }
 
+// terminateRunnerSidecars terminates the sidecars of the chaos-runner pod, which is retained after the chaos-runner
+// has finished, as the sidecars don't exit by themselves. The active deadline of the pod is set to its current age,
+// so that the kubelet stops its remaining containers while the pod, along with the logs of its containers, is retained
+func (r *ChaosEngineReconciler) terminateRunnerSidecars(engine *chaosTypes.EngineInfo) error {
+	if len(engine.Instance.Spec.Components.Sidecar) == 0 {
+		return nil
+	}
+
+	var runnerPod corev1.Pod
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: engine.Instance.Name + "-runner", Namespace: engine.Instance.Namespace}, &runnerPod); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get chaos-runner pod, due to error: %v", err)
+	}
+	if runnerPod.DeletionTimestamp != nil || runnerPod.Status.Phase != corev1.PodRunning || runnerPod.Spec.ActiveDeadlineSeconds != nil {
+		return nil
+	}
+
+	start := runnerPod.CreationTimestamp.Time
+	if runnerPod.Status.StartTime != nil {
+		start = runnerPod.Status.StartTime.Time
+	}
+	deadline := int64(time.Since(start).Seconds())
+	if deadline < 1 {
+		deadline = 1
+	}
+
+	patch := client.MergeFrom(runnerPod.DeepCopy())
+	runnerPod.Spec.ActiveDeadlineSeconds = &deadline
+	if err := r.Client.Patch(context.TODO(), &runnerPod, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch the active deadline of chaos-runner pod, due to error: %v", err)
+	}
+	return nil
+}
+
+// reconcileForTimeout forcefully aborts the ChaosEngine once its activeDeadlineSeconds is exceeded
+func (r *ChaosEngineReconciler) reconcileForTimeout(engine *chaosTypes.EngineInfo) (reconcile.Result, error) {
+	chaosTypes.Log.Info("ChaosEngine has exceeded its active deadline, aborting the chaos", "chaosengine", engine.Instance.Name, "activeDeadlineSeconds", engine.Instance.Spec.ActiveDeadlineSeconds)
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	engine.Instance.Spec.EngineState = litmuschaosv1alpha1.EngineStateStop
+	setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionAborted, v1.ConditionTrue, "ActiveDeadlineExceeded", fmt.Sprintf("ChaosEngine is aborted after exceeding the activeDeadlineSeconds of %d", engine.Instance.Spec.ActiveDeadlineSeconds))
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos timeout) Unable to update chaosengine")
+		return reconcile.Result{}, fmt.Errorf("unable to patch state of chaosEngine Resource, due to error: %v", err)
+	}
+	r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosEngineTimeout", "ChaosEngine exceeded the activeDeadlineSeconds of %d, aborting the chaos", engine.Instance.Spec.ActiveDeadlineSeconds)
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: engine.Instance.Name, Namespace: engine.Instance.Namespace}}
+	return r.reconcileForDelete(engine, request)
+}
+
 // reconcileForComplete reconciles for graceful completion of Chaos Engine
 func (r *ChaosEngineReconciler) reconcileForComplete(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
+	if err := r.syncChaosResults(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to sync chaosresults")
+		return reconcile.Result{}, err
+	}
+
 	if _, err := r.gracefullyRemoveDefaultChaosResources(engine, request); err != nil {
 		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to delete chaos pods upon chaos completion")
 		return reconcile.Result{}, err
 	}
 
+	if err := r.terminateRunnerSidecars(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to terminate the sidecars of chaos-runner pod")
+		return reconcile.Result{}, err
+	}
+
 	if err := r.updateEngineState(engine, litmuschaosv1alpha1.EngineStateStop); err != nil {
 		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to update chaosengine")
 		return reconcile.Result{}, fmt.Errorf("unable to Update Engine State: %v", err)
@@ -508,6 +918,7 @@ func (r *ChaosEngineReconciler) reconcileForRestartAfterComplete(engine *chaosTy
 
 	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
 	engine.Instance.Status.Experiments = nil
+	engine.Instance.Status.Conditions = nil
 
 	// finalizers have been retained in a completed chaosengine till this point (as chaos pods may be "retained")
 	// as per the jobCleanUpPolicy. Stale finalizer is removed so that initEngine() generates the
@@ -527,9 +938,10 @@ func (r *ChaosEngineReconciler) reconcileForRestartAfterComplete(engine *chaosTy
 
 // initEngine initialize Chaos Engine, and add a finalizer to it.
 func (r *ChaosEngineReconciler) initEngine(engine *chaosTypes.EngineInfo) (bool, error) {
-	if engine.Instance.Spec.EngineState == "" {
-		engine.Instance.Spec.EngineState = litmuschaosv1alpha1.EngineStateActive
-	}
+	// persist the defaults for the chaosengines, which are not defaulted by the webhook
+	spec := engine.Instance.Spec.DeepCopy()
+	engine.Instance.SetDefaults(r.RunnerImage)
+	isDefaulted := !reflect.DeepEqual(spec, &engine.Instance.Spec)
 
 	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && engine.Instance.Status.EngineStatus == "" {
 		engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
@@ -538,6 +950,7 @@ func (r *ChaosEngineReconciler) initEngine(engine *chaosTypes.EngineInfo) (bool,
 	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
 		if engine.Instance.ObjectMeta.Finalizers == nil {
 			engine.Instance.ObjectMeta.Finalizers = append(engine.Instance.ObjectMeta.Finalizers, finalizer)
+			setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionInitialized, v1.ConditionTrue, "ChaosEngineInitialized", "ChaosEngine is initialized")
 			if err := r.Client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 				if k8serrors.IsConflict(err) {
 					return true, err
@@ -546,6 +959,16 @@ func (r *ChaosEngineReconciler) initEngine(engine *chaosTypes.EngineInfo) (bool,
 			}
 			// generate the ChaosEngineInitialized event once finalizer has been added
 			r.Recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineInitialized", "Identifying app under test & launching %s", engine.Instance.Name+"-runner")
+			return false, nil
+		}
+	}
+
+	if isDefaulted {
+		if err := r.Client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			if k8serrors.IsConflict(err) {
+				return true, err
+			}
+			return false, fmt.Errorf("unable to set the defaults of ChaosEngine, because of Update Error: %v", err)
 		}
 	}
 
@@ -562,6 +985,61 @@ func (r *ChaosEngineReconciler) reconcileForCreationAndRunning(engine *chaosType
 		return reconcile.Result{}, err
 	}
 
+	// wait for the termination of the runner pod left over from the previous run
+	if runner.DeletionTimestamp != nil {
+		reqLogger.Info("Waiting for the termination of the previous engineRunner Pod", "Pod.Namespace", runner.Namespace, "Pod.Name", runner.Name)
+		return reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+
+	if reason := getRunnerFailureReason(&runner); reason != "" {
+		if requeue, err := r.updateEngineForError(engine, reason); err != nil {
+			if requeue {
+				return reconcile.Result{Requeue: true}, nil
+			}
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos error) Unable to update chaos engine")
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.syncChaosResults(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos running) Unable to sync chaosresults")
+		return reconcile.Result{}, err
+	}
+
+	// refresh the resolved targets, as the targets may change during the chaos, e.g. the deleted pods are replaced,
+	// at most once per targetsRefreshInterval to bound the lookups of the workloads and their owners
+	if isTargetsRefreshDue(engine) {
+		if targets, err := r.getTargets(engine); err != nil {
+			reqLogger.Info("Unable to resolve chaos targets", "error", err.Error())
+		} else if err := r.updateTargetsStatus(engine, targets); err != nil {
+			reqLogger.Info("Unable to update chaos targets", "error", err.Error())
+		}
+	}
+
+	// abort the chaos once the window of a chaosblackout aborting the running chaos is active
+	blackout, nextAbort, err := r.getActiveBlackout(engine)
+	if err != nil {
+		reqLogger.Info("Unable to check chaos blackouts", "error", err.Error())
+	} else if blackout != nil && blackout.abortRunning {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: engine.Instance.Name, Namespace: engine.Instance.Namespace}}
+		return r.abortEngine(engine, request, blackoutWindowActiveReason, fmt.Sprintf("chaos blackout %s is active until %s", blackout.name, blackout.end.Format(time.RFC3339)))
+	}
+
+	// requeue the engine at its deadline, so that the chaos is aborted in time
+	var requeueAfter time.Duration
+	if engine.Instance.Spec.ActiveDeadlineSeconds > 0 {
+		deadline := runner.CreationTimestamp.Add(time.Duration(engine.Instance.Spec.ActiveDeadlineSeconds) * time.Second)
+		if !time.Now().Before(deadline) {
+			return r.reconcileForTimeout(engine)
+		}
+		requeueAfter = time.Until(deadline)
+	}
+	// requeue the engine at the start of the next window aborting the running chaos
+	if !nextAbort.IsZero() && (requeueAfter == 0 || time.Until(nextAbort) < requeueAfter) {
+		requeueAfter = time.Until(nextAbort) + time.Second
+	}
+
 	isCompleted, err := r.checkRunnerContainerCompletedStatus(engine)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -579,94 +1057,237 @@ func (r *ChaosEngineReconciler) reconcileForCreationAndRunning(engine *chaosType
 			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completed) Unable to update chaos engine")
 			return reconcile.Result{}, err
 		}
+	} else if runner.Status.Phase == corev1.PodRunning {
+		if err := r.patchEngineCondition(engine, litmuschaosv1alpha1.ChaosEngineConditionRunning, v1.ConditionTrue, "RunnerPodRunning", "chaos-runner pod is running"); err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
 	reqLogger.Info("Skip reconcile: engineRunner Pod already exists", "Pod.Namespace", runner.Namespace, "Pod.Name", runner.Name)
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *ChaosEngineReconciler) createRunnerPod(engine *chaosTypes.EngineInfo, reqLogger logr.Logger) (reconcile.Result, error) {
+	// hold the chaosengine while the window of a chaosblackout is active
+	blackout, _, err := r.getActiveBlackout(engine)
+	if err != nil {
+		if errors.Is(err, errInvalidBlackout) {
+			return r.blockEngine(engine, "InvalidChaosBlackout", err)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check chaos blackouts")
+		return reconcile.Result{}, err
+	}
+	if blackout != nil {
+		return r.blockEngineForBlackout(engine, blackout)
+	}
+
 	if err := r.setExperimentDetails(engine); err != nil {
-		if updateEngineErr := r.updateEngineState(engine, litmuschaosv1alpha1.EngineStateStop); updateEngineErr != nil {
-			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
-			return reconcile.Result{}, fmt.Errorf("unable to Update Engine State: %v", err)
+		return r.stopEngineForInvalidSpec(engine, "InvalidChaosEngine", err)
+	}
+
+	// resolve every experiment before the guardrails, the queue and the locks, so
+	// that the chaosengine missing any of its experiments is stopped right away
+	if err := r.preflightChaosExperiments(engine); err != nil {
+		if errors.Is(err, errExperimentNotFound) {
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosExperimentNotFound", "%v", err)
+			return r.stopEngineForInvalidSpec(engine, "ChaosExperimentNotFound", err)
 		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to get chaos experiments")
 		return reconcile.Result{}, err
 	}
 
-	// Check if the engineRunner pod already exists, else create
-	if err := r.checkEngineRunnerPod(engine, reqLogger); err != nil {
-		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to get chaos resources")
+	// stop the chaosengine violating the chaospolicies applying to it
+	if err := r.checkChaosPolicies(engine); err != nil {
+		if errors.Is(err, errPolicyViolated) {
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosPolicyViolated", "%v", err)
+			return r.stopEngineForInvalidSpec(engine, "ChaosPolicyViolated", err)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check chaos policies")
 		return reconcile.Result{}, err
 	}
-	return reconcile.Result{}, nil
-}
 
-func (r *ChaosEngineReconciler) setExperimentDetails(engine *chaosTypes.EngineInfo) error {
-	// Get the image for runner pod from chaosengine spec,operator env or default values.
-	setChaosResourceImage(engine)
+	targets, err := r.getTargets(engine)
+	if err != nil {
+		if errors.Is(err, errInvalidSelector) {
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "InvalidSelectors", "%v", err)
+			return r.stopEngineForInvalidSpec(engine, "InvalidSelectors", err)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to resolve chaos targets")
+		return reconcile.Result{}, err
+	}
+	engine.Targets = targets
+	chaosTypes.Log.Info("Targets derived from Chaosengine is ", "targets", engine.Targets)
 
-	if engine.Selectors != nil && engine.Selectors.Workloads == nil && engine.Selectors.Pods == nil {
-		return fmt.Errorf("specify one out of workloads or pods")
+	// preview the resolved targets in the status, before the chaos is injected
+	if err := r.updateTargetsStatus(engine, targets); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to update chaos targets")
+		return reconcile.Result{}, err
+	}
+	if err := r.checkBlastRadius(engine); err != nil {
+		if errors.Is(err, errBlastRadiusExceeded) {
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "BlastRadiusExceeded", "%v", err)
+			return r.stopEngineForInvalidSpec(engine, "BlastRadiusExceeded", err)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check blast radius")
+		return reconcile.Result{}, err
+	}
+	if r.RequireNamespaceOptIn {
+		if err := r.checkNamespaceOptIn(engine, targets); err != nil {
+			if errors.Is(err, errNamespaceNotOptedIn) {
+				r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "NamespaceNotOptedIn", "%v", err)
+				return r.stopEngineForInvalidSpec(engine, "NamespaceNotOptedIn", err)
+			}
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check namespace opt-in")
+			return reconcile.Result{}, err
+		}
 	}
 
-	if (engine.AppInfo.AppKind != "") != (engine.AppInfo.Applabel != "") {
-		return fmt.Errorf("incomplete appinfo, provide appkind and applabel both")
+	// hold the chaosengine while the disruption of the targets would break their poddisruptionbudgets
+	if err := r.checkDisruptionBudgets(engine); err != nil {
+		if errors.Is(err, errDisruptionBudgetExceeded) {
+			return r.blockEngine(engine, "DisruptionBudgetExceeded", err)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check poddisruptionbudgets")
+		return reconcile.Result{}, err
+	}
+	if err := r.unblockEngine(engine); err != nil {
+		return reconcile.Result{}, err
 	}
 
-	engine.Targets = getTargets(engine)
+	// queue the chaosengine while the concurrency limits don't allow to launch it
+	if r.MaxActiveEngines > 0 || r.MaxActiveEnginesPerNamespace > 0 {
+		position, limit, err := r.getQueuePosition(engine)
+		if err != nil {
+			r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to check concurrency limits")
+			return reconcile.Result{}, err
+		}
+		if position > 0 {
+			return r.holdEngine(engine, litmuschaosv1alpha1.EngineStatusQueued, litmuschaosv1alpha1.ChaosEngineConditionQueued, concurrencyLimitReason, fmt.Errorf("concurrency limit reached, %s", limit), position)
+		}
+	}
 
-	var appExperiments []string
-	for _, exp := range engine.Instance.Spec.Experiments {
-		appExperiments = append(appExperiments, exp.Name)
+	// take the locks of the targets, so that no other chaosengine targets them at the same time
+	if err := r.acquireTargetLocks(engine); err != nil {
+		if errors.Is(err, errTargetLocked) {
+			return r.holdEngine(engine, litmuschaosv1alpha1.EngineStatusQueued, litmuschaosv1alpha1.ChaosEngineConditionQueued, "TargetLocked", err, 0)
+		}
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to lock chaos targets")
+		return reconcile.Result{}, err
+	}
+	if err := r.resumeEngine(engine, litmuschaosv1alpha1.EngineStatusQueued, litmuschaosv1alpha1.ChaosEngineConditionQueued, "Dequeued", "ChaosEngine is no longer queued"); err != nil {
+		return reconcile.Result{}, err
 	}
-	engine.AppExperiments = appExperiments
 
-	chaosTypes.Log.Info("Targets derived from Chaosengine is ", "targets", engine.Targets)
-	chaosTypes.Log.Info("Exp list derived from chaosengine is ", "appExpirements", appExperiments)
-	chaosTypes.Log.Info("Runner image derived from chaosengine is", "runnerImage", engine.Instance.Spec.Components.Runner.Image)
-	return nil
-}
+	// every launch of the chaos-runner is a new run, identified by its run ID
+	engine.RunID = string(uuid.NewUUID())
+	if err := r.applyRunContext(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to create run context")
+		return reconcile.Result{}, err
+	}
 
-func getTargets(engine *chaosTypes.EngineInfo) string {
-	if engine.Selectors == nil && reflect.DeepEqual(engine.AppInfo, litmuschaosv1alpha1.ApplicationParams{}) {
-		return ""
+	// Check if the engineRunner pod already exists, else create
+	if err := r.checkEngineRunnerPod(engine, reqLogger); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to get chaos resources")
+		return reconcile.Result{}, err
 	}
 
-	var targets []string
+	if err := r.patchEngineCondition(engine, litmuschaosv1alpha1.ChaosEngineConditionRunnerScheduled, v1.ConditionTrue, "RunnerPodCreated", fmt.Sprintf("chaos-runner pod %s is created", engine.Instance.Name+"-runner")); err != nil {
+		return reconcile.Result{}, err
+	}
+	metrics.RecordEngineStarted(engine.Instance.Namespace, engine.Instance.Name)
+	return reconcile.Result{}, nil
+}
 
-	if engine.Selectors != nil {
-		if engine.Selectors.Workloads != nil {
-			for _, w := range engine.Selectors.Workloads {
-				var filter string
-				if w.Names != "" {
-					filter = w.Names
-				} else {
-					filter = w.Labels
-				}
+// stopEngineForInvalidSpec stops the chaosengine which can't be launched, recording the reason in its conditions
+func (r *ChaosEngineReconciler) stopEngineForInvalidSpec(engine *chaosTypes.EngineInfo, reason string, err error) (reconcile.Result, error) {
+	if updateEngineErr := r.updateEngineState(engine, litmuschaosv1alpha1.EngineStateStop); updateEngineErr != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
+		return reconcile.Result{}, fmt.Errorf("unable to Update Engine State: %v", updateEngineErr)
+	}
+	if conditionErr := r.patchEngineCondition(engine, litmuschaosv1alpha1.ChaosEngineConditionFailed, v1.ConditionTrue, reason, err.Error()); conditionErr != nil {
+		return reconcile.Result{}, conditionErr
+	}
+	metrics.RecordEngineFailed(engine.Instance.Namespace, engine.Instance.Name, 0)
+	return reconcile.Result{}, err
+}
 
-				target := strings.Join([]string{string(w.Kind), w.Namespace, fmt.Sprintf("[%v]", filter)}, ":")
-				targets = append(targets, target)
+// getChaosExperiments fetches every experiment listed in the chaosengine, along with the names of the missing experiments
+func (r *ChaosEngineReconciler) getChaosExperiments(engine *chaosTypes.EngineInfo) ([]litmuschaosv1alpha1.ChaosExperiment, []string, error) {
+	var experiments []litmuschaosv1alpha1.ChaosExperiment
+	var missing []string
+	for _, exp := range engine.Instance.Spec.Experiments {
+		var experiment litmuschaosv1alpha1.ChaosExperiment
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: exp.Name, Namespace: engine.Instance.Namespace}, &experiment); err != nil {
+			if k8serrors.IsNotFound(err) {
+				missing = append(missing, exp.Name)
+				continue
 			}
-			return strings.Join(targets, ";")
+			return nil, nil, err
 		}
+		experiments = append(experiments, experiment)
+	}
+	return experiments, missing, nil
+}
+
+// preflightChaosExperiments checks that every experiment listed in the chaosengine exists,
+// marking the missing experiments as not found inside the chaosengine status
+func (r *ChaosEngineReconciler) preflightChaosExperiments(engine *chaosTypes.EngineInfo) error {
+	_, missing, err := r.getChaosExperiments(engine)
+	if err != nil {
+		return fmt.Errorf("unable to get chaosexperiments, due to error: %v", err)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	for _, name := range missing {
+		setExperimentStatusNotFound(engine.Instance, name)
+	}
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch experiment statuses of chaosEngine Resource, due to error: %v", err)
+	}
+	return fmt.Errorf("%w: %s in namespace %s", errExperimentNotFound, strings.Join(missing, ","), engine.Instance.Namespace)
+}
 
-		for _, w := range engine.Selectors.Pods {
-			target := strings.Join([]string{"pod", w.Namespace, fmt.Sprintf("[%v]", w.Names)}, ":")
-			targets = append(targets, target)
+// setExperimentStatusNotFound marks the experiment as not found inside the chaosengine status
+func setExperimentStatusNotFound(instance *litmuschaosv1alpha1.ChaosEngine, name string) {
+	for i := range instance.Status.Experiments {
+		if instance.Status.Experiments[i].Name == name {
+			instance.Status.Experiments[i].Status = litmuschaosv1alpha1.ExperimentStatusNotFound
+			instance.Status.Experiments[i].LastUpdateTime = v1.Now()
+			return
 		}
-		return strings.Join(targets, ";")
 	}
+	instance.Status.Experiments = append(instance.Status.Experiments, litmuschaosv1alpha1.ExperimentStatuses{
+		Name:           name,
+		Status:         litmuschaosv1alpha1.ExperimentStatusNotFound,
+		LastUpdateTime: v1.Now(),
+	})
+}
+
+func (r *ChaosEngineReconciler) setExperimentDetails(engine *chaosTypes.EngineInfo) error {
+	// Get the image for runner pod from chaosengine spec,operator or default values.
+	engine.Instance.SetDefaults(r.RunnerImage)
 
-	if engine.AppInfo.Appns == "" {
-		engine.AppInfo.Appns = engine.Instance.Namespace
+	if engine.Selectors != nil && engine.Selectors.Workloads == nil && engine.Selectors.Pods == nil && engine.Selectors.Nodes == nil {
+		return fmt.Errorf("specify one out of workloads, pods or nodes")
 	}
 
-	if engine.AppInfo.AppKind == "" {
-		engine.AppInfo.AppKind = "KIND"
+	if engine.AppInfo.IsIncomplete() {
+		return fmt.Errorf("incomplete appinfo, provide appkind and applabel both")
+	}
+
+	var appExperiments []string
+	for _, exp := range engine.Instance.Spec.Experiments {
+		appExperiments = append(appExperiments, exp.Name)
 	}
-	return strings.Join([]string{engine.AppInfo.AppKind, engine.AppInfo.Appns, fmt.Sprintf("[%v]", engine.AppInfo.Applabel)}, ":")
+	engine.AppExperiments = appExperiments
+
+	chaosTypes.Log.Info("Exp list derived from chaosengine is ", "appExpirements", appExperiments)
+	chaosTypes.Log.Info("Runner image derived from chaosengine is", "runnerImage", engine.Instance.Spec.Components.Runner.Image)
+	return nil
 }
 
 // updateExperimentStatusesForStop updates ChaosEngine.Status.Experiment with Abort Status.
@@ -691,6 +1312,8 @@ func (r *ChaosEngineReconciler) updateEngineForComplete(engine *chaosTypes.Engin
 	if engine.Instance.Status.EngineStatus != litmuschaosv1alpha1.EngineStatusCompleted {
 		engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusCompleted
 		engine.Instance.Spec.EngineState = litmuschaosv1alpha1.EngineStateStop
+		setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionRunning, v1.ConditionFalse, "ChaosEngineCompleted", "chaos-runner pod has completed")
+		setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionCompleted, v1.ConditionTrue, "ChaosEngineCompleted", "ChaosEngine is completed")
 		if err := r.Client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 			if k8serrors.IsConflict(err) {
 				return true, err
@@ -698,7 +1321,42 @@ func (r *ChaosEngineReconciler) updateEngineForComplete(engine *chaosTypes.Engin
 			return false, fmt.Errorf("unable to update ChaosEngine Status, due to update error: %v", err)
 		}
 		r.Recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineCompleted", "ChaosEngine completed, will delete or retain the resources according to jobCleanUpPolicy")
+		r.releaseTargetLocks(engine)
+		metrics.RecordEngineCompleted(engine.Instance.Namespace, engine.Instance.Name, getRunDuration(engine.Instance))
+	}
+
+	return false, nil
+}
+
+// updateEngineForError moves the ChaosEngine to error status and stops it, upon failure of the chaos-runner
+func (r *ChaosEngineReconciler) updateEngineForError(engine *chaosTypes.EngineInfo, reason string) (bool, error) {
+	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusError {
+		return false, nil
+	}
+
+	if err := r.removeChaosResourcesForError(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos error) Unable to delete chaos resources")
+		return false, err
+	}
+	if err := r.terminateRunnerSidecars(engine); err != nil {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos error) Unable to terminate the sidecars of chaos-runner pod")
+		return false, err
+	}
+
+	updateExperimentStatusesForStop(engine)
+	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusError
+	engine.Instance.Spec.EngineState = litmuschaosv1alpha1.EngineStateStop
+	setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionRunning, v1.ConditionFalse, "RunnerPodFailed", "chaos-runner pod has failed")
+	setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionFailed, v1.ConditionTrue, "RunnerPodFailed", reason)
+	if err := r.Client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
+		if k8serrors.IsConflict(err) {
+			return true, err
+		}
+		return false, fmt.Errorf("unable to update ChaosEngine Status, due to update error: %v", err)
 	}
+	r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosRunnerFailed", "chaos-runner pod failed with %s", reason)
+	r.releaseTargetLocks(engine)
+	metrics.RecordEngineFailed(engine.Instance.Namespace, engine.Instance.Name, getRunDuration(engine.Instance))
 
 	return false, nil
 }
@@ -707,6 +1365,7 @@ func (r *ChaosEngineReconciler) updateEngineForRestart(engine *chaosTypes.Engine
 	r.Recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "RestartInProgress", "ChaosEngine is restarted")
 	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
 	engine.Instance.Status.Experiments = nil
+	engine.Instance.Status.Conditions = nil
 	if err := r.Client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 		if k8serrors.IsConflict(err) {
 			return true, err
@@ -719,10 +1378,6 @@ func (r *ChaosEngineReconciler) updateEngineForRestart(engine *chaosTypes.Engine
 
 // updateChaosStatus update the chaos status inside the chaosresult
 func (r *ChaosEngineReconciler) updateChaosStatus(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
-	if err := r.waitForChaosPodTermination(engine, request); err != nil {
-		return err
-	}
-
 	// skipping CRD validation for the namespace scoped operator
 	if os.Getenv("WATCH_NAMESPACE") == "" {
 		found, err := isResultCRDAvailable()
@@ -766,26 +1421,144 @@ func (r *ChaosEngineReconciler) updateChaosResult(engine *chaosTypes.EngineInfo,
 	return nil
 }
 
-// waitForChaosPodTermination wait until the termination of chaos pod after abort
-func (r *ChaosEngineReconciler) waitForChaosPodTermination(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
+// syncChaosResults mirrors the status of the chaosresults of the chaosengine into the experiment statuses
+// and derives the overall verdict of the chaosengine
+func (r *ChaosEngineReconciler) syncChaosResults(engine *chaosTypes.EngineInfo) error {
+	chaosresultList := &litmuschaosv1alpha1.ChaosResultList{}
 	opts := []client.ListOption{
-		client.InNamespace(request.NamespacedName.Namespace),
+		client.InNamespace(engine.Instance.Namespace),
 		client.MatchingLabels{"chaosUID": string(engine.Instance.UID)},
 	}
+	if err := r.Client.List(context.TODO(), chaosresultList, opts...); err != nil {
+		return fmt.Errorf("unable to list chaosresults, due to error: %v", err)
+	}
 
-	return retry.
-		Times(uint(180)).
-		Wait(1 * time.Second).
-		Try(func(attempt uint) error {
-			chaosPodList := &corev1.PodList{}
-			if err := r.Client.List(context.TODO(), chaosPodList, opts...); err != nil {
-				return err
-			}
-			if len(chaosPodList.Items) != 0 {
-				return errors.Errorf("chaos pods are not deleted yet")
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	status := engine.Instance.Status.DeepCopy()
+
+	for _, result := range chaosresultList.Items {
+		updateExperimentStatusFromResult(engine.Instance, result)
+	}
+	engine.Instance.Status.Verdict = getEngineVerdict(engine.Instance)
+
+	if reflect.DeepEqual(status, &engine.Instance.Status) {
+		return nil
+	}
+
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch experiment statuses of chaosEngine Resource, due to error: %v", err)
+	}
+	recordExperimentVerdicts(engine.Instance, status.Experiments)
+	return nil
+}
+
+// recordExperimentVerdicts records the final verdicts reached by the experiments since the previous experiment statuses
+func recordExperimentVerdicts(instance *litmuschaosv1alpha1.ChaosEngine, previous []litmuschaosv1alpha1.ExperimentStatuses) {
+	for _, exp := range instance.Status.Experiments {
+		switch litmuschaosv1alpha1.ResultVerdict(exp.Verdict) {
+		case litmuschaosv1alpha1.ResultVerdictPassed, litmuschaosv1alpha1.ResultVerdictFailed, litmuschaosv1alpha1.ResultVerdictError, litmuschaosv1alpha1.ResultVerdictStopped:
+		default:
+			continue
+		}
+		isRecorded := false
+		for _, prev := range previous {
+			if prev.Name == exp.Name && prev.Verdict == exp.Verdict {
+				isRecorded = true
+				break
 			}
-			return nil
+		}
+		if !isRecorded {
+			metrics.RecordExperimentVerdict(instance.Namespace, instance.Name, exp.Name, exp.Verdict)
+		}
+	}
+}
+
+// getRunDuration returns the time elapsed since the chaos-runner of the chaosengine was scheduled,
+// or zero if it was never scheduled
+func getRunDuration(instance *litmuschaosv1alpha1.ChaosEngine) time.Duration {
+	condition := meta.FindStatusCondition(instance.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionRunnerScheduled)
+	if condition == nil || condition.Status != v1.ConditionTrue {
+		return 0
+	}
+	return time.Since(condition.LastTransitionTime.Time)
+}
+
+// updateExperimentStatusFromResult updates the experiment status corresponding to the given chaosresult
+func updateExperimentStatusFromResult(instance *litmuschaosv1alpha1.ChaosEngine, result litmuschaosv1alpha1.ChaosResult) {
+	resultStatus := result.Status.ExperimentStatus
+
+	index := -1
+	for i := range instance.Status.Experiments {
+		if instance.Status.Experiments[i].Name == result.Spec.ExperimentName {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		instance.Status.Experiments = append(instance.Status.Experiments, litmuschaosv1alpha1.ExperimentStatuses{
+			Name:   result.Spec.ExperimentName,
+			Status: litmuschaosv1alpha1.ExperimentStatusWaiting,
 		})
+		index = len(instance.Status.Experiments) - 1
+	}
+
+	experiment := &instance.Status.Experiments[index]
+	if experiment.Phase == resultStatus.Phase && experiment.Verdict == string(resultStatus.Verdict) &&
+		experiment.ProbeSuccessPercentage == resultStatus.ProbeSuccessPercentage && reflect.DeepEqual(experiment.ErrorOutput, resultStatus.ErrorOutput) {
+		return
+	}
+
+	experiment.Phase = resultStatus.Phase
+	experiment.Verdict = string(resultStatus.Verdict)
+	experiment.ProbeSuccessPercentage = resultStatus.ProbeSuccessPercentage
+	experiment.ErrorOutput = resultStatus.ErrorOutput
+	experiment.LastUpdateTime = v1.Now()
+}
+
+// getEngineVerdict derives the overall verdict of the chaosengine from the verdicts of its experiments.
+// A failed experiment fails the chaosengine, while it only passes once every experiment has passed
+func getEngineVerdict(instance *litmuschaosv1alpha1.ChaosEngine) litmuschaosv1alpha1.ResultVerdict {
+	if len(instance.Status.Experiments) == 0 {
+		return ""
+	}
+
+	priority := map[litmuschaosv1alpha1.ResultVerdict]int{
+		litmuschaosv1alpha1.ResultVerdictPassed:  0,
+		litmuschaosv1alpha1.ResultVerdictAwaited: 1,
+		litmuschaosv1alpha1.ResultVerdictStopped: 2,
+		litmuschaosv1alpha1.ResultVerdictError:   3,
+		litmuschaosv1alpha1.ResultVerdictFailed:  4,
+	}
+
+	verdict := litmuschaosv1alpha1.ResultVerdictPassed
+	for _, exp := range instance.Spec.Experiments {
+		expVerdict := litmuschaosv1alpha1.ResultVerdictAwaited
+		for _, status := range instance.Status.Experiments {
+			if status.Name == exp.Name {
+				expVerdict = litmuschaosv1alpha1.ResultVerdict(status.Verdict)
+				break
+			}
+		}
+		if _, ok := priority[expVerdict]; !ok {
+			expVerdict = litmuschaosv1alpha1.ResultVerdictAwaited
+		}
+		if priority[expVerdict] > priority[verdict] {
+			verdict = expVerdict
+		}
+	}
+	return verdict
+}
+
+// getEngineForChaosResult maps the chaosresult to the chaosengine which has created it
+func getEngineForChaosResult(obj client.Object) []reconcile.Request {
+	result, ok := obj.(*litmuschaosv1alpha1.ChaosResult)
+	if !ok || result.Labels["chaosUID"] == "" || result.Spec.EngineName == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: result.Spec.EngineName, Namespace: result.Namespace}},
+	}
 }
 
 // getChaosStatus return the target application details along with their chaos status
@@ -856,8 +1629,25 @@ func updateTargets(name, status string, data *[]litmuschaosv1alpha1.TargetDetail
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChaosEngineReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := metrics.RegisterStateCollector(mgr.GetClient()); err != nil {
+		return err
+	}
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&litmuschaosv1alpha1.ChaosEngine{}).
 		Owns(&corev1.Pod{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &litmuschaosv1alpha1.ChaosResult{}}, handler.EnqueueRequestsFromMapFunc(getEngineForChaosResult)).
+		Watches(&source.Kind{Type: &litmuschaosv1alpha1.ChaosBlackout{}}, handler.EnqueueRequestsFromMapFunc(r.getEnginesForBlackout))
+
+	if r.OperatorNamespace != "" {
+		killSwitchCache, err := newKillSwitchCache(mgr, r.OperatorNamespace)
+		if err != nil {
+			return err
+		}
+		if err := mgr.Add(killSwitchCache); err != nil {
+			return err
+		}
+		r.killSwitchReader = killSwitchCache
+		bldr = bldr.Watches(source.NewKindWithCache(&corev1.ConfigMap{}, killSwitchCache), handler.EnqueueRequestsFromMapFunc(r.getEnginesForKillSwitch))
+	}
+	return bldr.Complete(r)
 }
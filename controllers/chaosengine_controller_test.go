@@ -15,13 +15,17 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"strings"
 	"testing"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -31,19 +35,22 @@ import (
 	"k8s.io/client-go/tools/record"
 	litmusFakeClientset "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/litmuschaos/chaos-operator/pkg/metrics"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestGetChaosRunnerENV(t *testing.T) {
 	fakeEngineName := "Fake Engine"
 	fakeNameSpace := "Fake NameSpace"
 	fakeServiceAcc := "Fake Service Account"
-	fakeTargets := "fakeTargets"
+	fakeTargets := []chaosTypes.Target{{Kind: "deployment", Namespace: "default", Labels: "app=nginx"}}
 	fakeAppLabel := "Fake Label"
 	fakeAppKind := "Fake Kind"
 	fakeAExList := []string{"fake string"}
 	fakeAuxilaryAppInfo := "ns1:name=percona,ns2:run=nginx"
 	fakeClientUUID := "12345678-9012-3456-7890-123456789012"
+	fakeRunID := "fake-run-id"
 
 	tests := map[string]struct {
 		instance       *v1alpha1.ChaosEngine
@@ -55,6 +62,7 @@ func TestGetChaosRunnerENV(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      fakeEngineName,
 					Namespace: fakeNameSpace,
+					UID:       "fake-uid",
 				},
 				Spec: v1alpha1.ChaosEngineSpec{
 					ChaosServiceAccount: fakeServiceAcc,
@@ -63,7 +71,9 @@ func TestGetChaosRunnerENV(t *testing.T) {
 						Appns:    fakeNameSpace,
 						AppKind:  fakeAppKind,
 					},
-					AuxiliaryAppInfo: fakeAuxilaryAppInfo,
+					AuxiliaryAppInfo:              fakeAuxilaryAppInfo,
+					DefaultHealthCheck:            true,
+					TerminationGracePeriodSeconds: 30,
 				},
 			},
 			aExList: fakeAExList,
@@ -74,7 +84,7 @@ func TestGetChaosRunnerENV(t *testing.T) {
 				},
 				{
 					Name:  "TARGETS",
-					Value: fakeTargets,
+					Value: `[{"kind":"deployment","namespace":"default","labels":"app=nginx"}]`,
 				},
 				{
 					Name:  "EXPERIMENT_LIST",
@@ -96,16 +106,35 @@ func TestGetChaosRunnerENV(t *testing.T) {
 					Name:  "CHAOS_NAMESPACE",
 					Value: fakeNameSpace,
 				},
+				{
+					Name:  "CHAOS_UID",
+					Value: "fake-uid",
+				},
+				{
+					Name:  "RUN_ID",
+					Value: fakeRunID,
+				},
+				{
+					Name:  "DEFAULT_HEALTH_CHECK",
+					Value: "true",
+				},
+				{
+					Name:  "RUN_CONTEXT_PATH",
+					Value: "/etc/litmus/run-context/run-context.json",
+				},
+				{
+					Name:  "TERMINATION_GRACE_PERIOD_SECONDS",
+					Value: "30",
+				},
 			},
 		},
 	}
 	for name, mock := range tests {
 		t.Run(name, func(t *testing.T) {
-			engine := &chaosTypes.EngineInfo{Instance: mock.instance, Targets: fakeTargets, AppExperiments: fakeAExList}
+			engine := &chaosTypes.EngineInfo{Instance: mock.instance, Targets: fakeTargets, AppExperiments: fakeAExList, RunID: fakeRunID}
 			actualResult := getChaosRunnerENV(engine, fakeClientUUID)
-			println(len(actualResult))
-			if len(actualResult) != 7 {
-				t.Fatalf("Test %q failed: expected array length to be 7", name)
+			if len(actualResult) != len(mock.expectedResult) {
+				t.Fatalf("Test %q failed: expected array length to be %d, received %d", name, len(mock.expectedResult), len(actualResult))
 			}
 			for index, result := range actualResult {
 				if result.Value != mock.expectedResult[index].Value {
@@ -116,6 +145,42 @@ func TestGetChaosRunnerENV(t *testing.T) {
 	}
 }
 
+func TestApplyRunContext(t *testing.T) {
+	r := CreateFakeClient(t)
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine-run-context",
+				Namespace: "default",
+				UID:       "fake-uid",
+				Labels:    map[string]string{"team": "sre"},
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				DefaultHealthCheck:            true,
+				TerminationGracePeriodSeconds: 30,
+			},
+		},
+		AppExperiments: []string{"pod-delete"},
+	}
+
+	for _, runID := range []string{"first-run", "second-run"} {
+		engine.RunID = runID
+		if err := r.applyRunContext(engine); err != nil {
+			t.Fatalf("unable to apply run context: %v", err)
+		}
+
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "engine-run-context-run-context", Namespace: "default"}, configMap); err != nil {
+			t.Fatalf("unable to get run context configmap: %v", err)
+		}
+		var runContext chaosTypes.RunContext
+		if err := json.Unmarshal([]byte(configMap.Data[chaosTypes.RunContextFileName]), &runContext); err != nil {
+			t.Fatalf("unable to unmarshal run context: %v", err)
+		}
+		require.Equal(t, getRunContext(engine), runContext)
+	}
+}
+
 func TestUpdateEngineForComplete(t *testing.T) {
 	tests := map[string]struct {
 		engine chaosTypes.EngineInfo
@@ -545,6 +610,323 @@ func TestNewGoRunnerPodForCR(t *testing.T) {
 	}
 }
 
+func TestNewGoRunnerPodForCRWithSidecar(t *testing.T) {
+	tests := map[string]struct {
+		sidecars              []v1alpha1.Sidecar
+		runnerSecrets         []v1alpha1.Secret
+		runnerConfigMaps      []v1alpha1.ConfigMap
+		expectedContainers    []string
+		expectedVolumes       []string
+		expectedSecretVolume  map[string]string
+		expectedSidecarMounts []string
+	}{
+		"Test Positive-1": {
+			expectedContainers: []string{"chaos-runner"},
+			expectedVolumes:    []string{"test-runner-run-context"},
+		},
+		"Test Positive-2": {
+			sidecars: []v1alpha1.Sidecar{
+				{
+					Image: "fake-sidecar-image",
+					ENV: []corev1.EnvVar{
+						{
+							Name:  "LOG_LEVEL",
+							Value: "debug",
+						},
+					},
+					Secrets: []v1alpha1.Secret{
+						{
+							Name:      "sidecar-secret",
+							MountPath: "/etc/sidecar",
+						},
+					},
+				},
+			},
+			expectedContainers: []string{"chaos-runner", "sidecar-0"},
+			expectedVolumes:    []string{"test-runner-run-context", "sidecar-secret"},
+		},
+		"Test Positive-3": {
+			sidecars: []v1alpha1.Sidecar{
+				{
+					Image: "fake-sidecar-image",
+					Secrets: []v1alpha1.Secret{
+						{
+							Name:      "shared-secret",
+							MountPath: "/etc/sidecar",
+						},
+					},
+				},
+				{
+					Image:           "fake-sidecar-image",
+					ImagePullPolicy: corev1.PullAlways,
+				},
+			},
+			runnerSecrets: []v1alpha1.Secret{
+				{
+					Name:      "shared-secret",
+					MountPath: "/etc/runner",
+				},
+			},
+			expectedContainers: []string{"chaos-runner", "sidecar-0", "sidecar-1"},
+			expectedVolumes:    []string{"test-runner-run-context", "shared-secret"},
+		},
+		"Test Positive-4": {
+			// the sidecar secret named like a runner configmap is mounted from its own volume
+			sidecars: []v1alpha1.Sidecar{
+				{
+					Image: "fake-sidecar-image",
+					Secrets: []v1alpha1.Secret{
+						{
+							Name:      "shipper-config",
+							MountPath: "/etc/sidecar",
+						},
+					},
+				},
+			},
+			runnerConfigMaps: []v1alpha1.ConfigMap{
+				{
+					Name:      "shipper-config",
+					MountPath: "/etc/runner",
+				},
+			},
+			expectedContainers: []string{"chaos-runner", "sidecar-0"},
+			expectedVolumes:    []string{"shipper-config", "test-runner-run-context", "sidecar-secret-shipper-config"},
+			expectedSecretVolume: map[string]string{
+				"sidecar-secret-shipper-config": "shipper-config",
+			},
+			expectedSidecarMounts: []string{"sidecar-secret-shipper-config"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			exp := v1alpha1.ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod-delete",
+					Namespace: "test",
+				},
+			}
+			if err := r.Client.Create(context.TODO(), &exp); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil", name)
+			}
+			engine := chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-runner",
+						Namespace: "test",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Image:      "fake-runner-image",
+								Secrets:    mock.runnerSecrets,
+								ConfigMaps: mock.runnerConfigMaps,
+							},
+							Sidecar: mock.sidecars,
+						},
+						Experiments: []v1alpha1.ExperimentList{
+							{
+								Name: "pod-delete",
+							},
+						},
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+			runnerPod, err := r.newGoRunnerPodForCR(&engine)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			var containers, volumes []string
+			for _, c := range runnerPod.Spec.Containers {
+				containers = append(containers, c.Name)
+			}
+			for _, v := range runnerPod.Spec.Volumes {
+				volumes = append(volumes, v.Name)
+			}
+			require.Equal(t, mock.expectedContainers, containers)
+			require.Equal(t, mock.expectedVolumes, volumes)
+			for _, v := range runnerPod.Spec.Volumes {
+				if secretName, ok := mock.expectedSecretVolume[v.Name]; ok {
+					require.NotNil(t, v.Secret, "expected volume %s to be a secret volume", v.Name)
+					require.Equal(t, secretName, v.Secret.SecretName)
+				}
+			}
+			if mock.expectedSidecarMounts != nil {
+				var mounts []string
+				for _, mount := range runnerPod.Spec.Containers[1].VolumeMounts {
+					mounts = append(mounts, mount.Name)
+				}
+				require.Equal(t, mock.expectedSidecarMounts, mounts)
+			}
+
+			for i, sidecar := range mock.sidecars {
+				c := runnerPod.Spec.Containers[i+1]
+				require.Equal(t, sidecar.Image, c.Image)
+				require.Equal(t, len(sidecar.Secrets), len(c.VolumeMounts))
+				if sidecar.ImagePullPolicy == "" {
+					require.Equal(t, corev1.PullIfNotPresent, c.ImagePullPolicy)
+				}
+			}
+		})
+	}
+}
+
+func TestNewGoRunnerPodForCRWithMultipleExperiments(t *testing.T) {
+	r := CreateFakeClient(t)
+	experiments := []v1alpha1.ChaosExperiment{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod-delete",
+				Namespace: "test",
+			},
+			Spec: v1alpha1.ChaosExperimentSpec{
+				Definition: v1alpha1.ExperimentDef{
+					SecurityContext: v1alpha1.SecurityContext{
+						ContainerSecurityContext: corev1.SecurityContext{
+							Privileged: pointer.Bool(true),
+							Capabilities: &corev1.Capabilities{
+								Add: []corev1.Capability{"NET_ADMIN", "SYS_ADMIN"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod-cpu-hog",
+				Namespace: "test",
+			},
+			Spec: v1alpha1.ChaosExperimentSpec{
+				Definition: v1alpha1.ExperimentDef{
+					SecurityContext: v1alpha1.SecurityContext{
+						PodSecurityContext: corev1.PodSecurityContext{
+							RunAsUser:    pointer.Int64(1000),
+							RunAsNonRoot: pointer.Bool(true),
+						},
+						ContainerSecurityContext: corev1.SecurityContext{
+							Privileged: pointer.Bool(false),
+							Capabilities: &corev1.Capabilities{
+								Add:  []corev1.Capability{"NET_ADMIN"},
+								Drop: []corev1.Capability{"ALL"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for i := range experiments {
+		if err := r.Client.Create(context.TODO(), &experiments[i]); err != nil {
+			t.Fatalf("unable to create chaosexperiment: %v", err)
+		}
+	}
+	engine := chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runner",
+				Namespace: "test",
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				ChaosServiceAccount: "fake-serviceAccount",
+				Components: v1alpha1.ComponentParams{
+					Runner: v1alpha1.RunnerInfo{
+						Image: "fake-runner-image",
+					},
+				},
+				Experiments: []v1alpha1.ExperimentList{
+					{
+						Name: "pod-delete",
+					},
+					{
+						Name: "pod-cpu-hog",
+					},
+				},
+			},
+		},
+		AppExperiments: []string{"pod-delete", "pod-cpu-hog"},
+	}
+
+	runnerPod, err := r.newGoRunnerPodForCR(&engine)
+	if err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+	require.Equal(t, &corev1.PodSecurityContext{
+		RunAsUser:    pointer.Int64(1000),
+		RunAsNonRoot: pointer.Bool(true),
+	}, runnerPod.Spec.SecurityContext)
+	require.Equal(t, &corev1.SecurityContext{
+		Privileged: pointer.Bool(false),
+		Capabilities: &corev1.Capabilities{
+			Add:  []corev1.Capability{"NET_ADMIN"},
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}, runnerPod.Spec.Containers[0].SecurityContext)
+
+	engine.Instance.Spec.Experiments = append(engine.Instance.Spec.Experiments, v1alpha1.ExperimentList{Name: "node-drain"})
+	if _, err := r.newGoRunnerPodForCR(&engine); err == nil {
+		t.Fatalf("expected error not to be nil for the missing experiment")
+	}
+}
+
+func TestPreflightChaosExperiments(t *testing.T) {
+	tests := map[string]struct {
+		experiments     []string
+		isErr           bool
+		expectedMissing []string
+	}{
+		"Test Positive-1": {
+			experiments: []string{"pod-delete"},
+		},
+		"Test Negative-1": {
+			experiments:     []string{"pod-delete", "pod-cpu-hog", "node-drain"},
+			isErr:           true,
+			expectedMissing: []string{"pod-cpu-hog", "node-drain"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			exp := v1alpha1.ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod-delete",
+					Namespace: "default",
+				},
+			}
+			if err := r.Client.Create(context.TODO(), &exp); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil", name)
+			}
+			engine := chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "engine-preflight",
+						Namespace: "default",
+					},
+				},
+			}
+			for _, name := range mock.experiments {
+				engine.Instance.Spec.Experiments = append(engine.Instance.Spec.Experiments, v1alpha1.ExperimentList{Name: name})
+			}
+
+			err := r.preflightChaosExperiments(&engine)
+			if mock.isErr != (err != nil) {
+				t.Fatalf("Test %q failed: expected error %v, got %v", name, mock.isErr, err)
+			}
+
+			var missing []string
+			for _, status := range engine.Instance.Status.Experiments {
+				if status.Status == v1alpha1.ExperimentStatusNotFound {
+					missing = append(missing, status.Name)
+				}
+			}
+			require.Equal(t, mock.expectedMissing, missing)
+		})
+	}
+}
+
 func TestInitEngine(t *testing.T) {
 	tests := map[string]struct {
 		engine chaosTypes.EngineInfo
@@ -710,6 +1092,87 @@ func TestUpdateEngineState(t *testing.T) {
 	}
 }
 
+func TestPatchEngineCondition(t *testing.T) {
+	tests := map[string]struct {
+		engine        chaosTypes.EngineInfo
+		conditionType string
+		status        metav1.ConditionStatus
+		reason        string
+		expectedCount int
+	}{
+		"Test Positive-1": {
+			engine: chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "engine-condition-p1",
+						Namespace:  "default",
+						Generation: 2,
+					},
+				},
+			},
+			conditionType: v1alpha1.ChaosEngineConditionRunnerScheduled,
+			status:        metav1.ConditionTrue,
+			reason:        "RunnerPodCreated",
+			expectedCount: 1,
+		},
+		"Test Positive-2": {
+			engine: chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "engine-condition-p2",
+						Namespace:  "default",
+						Generation: 2,
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:   v1alpha1.ChaosEngineConditionInitialized,
+								Status: metav1.ConditionTrue,
+								Reason: "ChaosEngineInitialized",
+							},
+							{
+								Type:   v1alpha1.ChaosEngineConditionRunning,
+								Status: metav1.ConditionTrue,
+								Reason: "RunnerPodRunning",
+							},
+						},
+					},
+				},
+			},
+			conditionType: v1alpha1.ChaosEngineConditionRunning,
+			status:        metav1.ConditionFalse,
+			reason:        "ChaosEngineStopped",
+			expectedCount: 2,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			if err := r.Client.Create(context.TODO(), mock.engine.Instance); err != nil {
+				t.Fatalf("Test %q failed: unable to create engine: %v", name, err)
+			}
+
+			if err := r.patchEngineCondition(&mock.engine, mock.conditionType, mock.status, mock.reason, "fake-message"); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			engine := &v1alpha1.ChaosEngine{}
+			if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: mock.engine.Instance.Name, Namespace: mock.engine.Instance.Namespace}, engine); err != nil {
+				t.Fatalf("Test %q failed: unable to get engine: %v", name, err)
+			}
+			require.Equal(t, mock.expectedCount, len(engine.Status.Conditions))
+			require.Equal(t, mock.engine.Instance.Generation, engine.Status.ObservedGeneration)
+
+			condition := meta.FindStatusCondition(engine.Status.Conditions, mock.conditionType)
+			if condition == nil {
+				t.Fatalf("Test %q failed: expected condition %s to be present", name, mock.conditionType)
+			}
+			require.Equal(t, mock.status, condition.Status)
+			require.Equal(t, mock.reason, condition.Reason)
+		})
+	}
+}
+
 func TestCheckRunnerPodCompletedStatus(t *testing.T) {
 	tests := map[string]struct {
 		isErr  bool
@@ -806,6 +1269,626 @@ func TestCheckRunnerPodCompletedStatus(t *testing.T) {
 	}
 }
 
+func TestGetRunnerFailureReason(t *testing.T) {
+	tests := map[string]struct {
+		status   corev1.PodStatus
+		isFailed bool
+	}{
+		"Test Positive-1": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Running: &corev1.ContainerStateRunning{},
+						},
+					},
+				},
+			},
+			isFailed: false,
+		},
+		"Test Positive-2": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"},
+						},
+					},
+				},
+			},
+			isFailed: false,
+		},
+		"Test Positive-3": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Running: &corev1.ContainerStateRunning{},
+						},
+					},
+					{
+						Name: "sidecar-0",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+					},
+				},
+			},
+			isFailed: false,
+		},
+		"Test Positive-4": {
+			status: corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: "DeadlineExceeded",
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"},
+						},
+					},
+					{
+						Name: "sidecar-0",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 137},
+						},
+					},
+				},
+			},
+			isFailed: false,
+		},
+		"Test Negative-1": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+						},
+					},
+				},
+			},
+			isFailed: true,
+		},
+		"Test Negative-2": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+						},
+					},
+				},
+			},
+			isFailed: true,
+		},
+		"Test Negative-3": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "chaos-runner",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+					},
+				},
+			},
+			isFailed: true,
+		},
+		"Test Negative-4": {
+			status: corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: "Evicted",
+			},
+			isFailed: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason := getRunnerFailureReason(&corev1.Pod{Status: mock.status})
+			if mock.isFailed && reason == "" {
+				t.Fatalf("Test %q failed: expected failure reason not to be empty", name)
+			}
+			if !mock.isFailed && reason != "" {
+				t.Fatalf("Test %q failed: expected failure reason to be empty, got %q", name, reason)
+			}
+		})
+	}
+}
+
+func TestTerminateRunnerSidecars(t *testing.T) {
+	tests := map[string]struct {
+		sidecars         []v1alpha1.Sidecar
+		phase            corev1.PodPhase
+		expectedDeadline bool
+	}{
+		"Test Positive-1": {
+			sidecars:         []v1alpha1.Sidecar{{Image: "fluent/fluent-bit:2.1"}},
+			phase:            corev1.PodRunning,
+			expectedDeadline: true,
+		},
+		"Test Positive-2": {
+			phase:            corev1.PodRunning,
+			expectedDeadline: false,
+		},
+		"Test Positive-3": {
+			sidecars:         []v1alpha1.Sidecar{{Image: "fluent/fluent-bit:2.1"}},
+			phase:            corev1.PodSucceeded,
+			expectedDeadline: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "sidecar-engine",
+						Namespace: "default",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Components: v1alpha1.ComponentParams{
+							Sidecar: mock.sidecars,
+						},
+					},
+				},
+			}
+			startTime := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+			runnerPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sidecar-engine-runner",
+					Namespace: "default",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "chaos-runner"}, {Name: "sidecar-0"}},
+				},
+				Status: corev1.PodStatus{
+					Phase:     mock.phase,
+					StartTime: &startTime,
+				},
+			}
+			require.NoError(t, r.Client.Create(context.TODO(), runnerPod))
+
+			if err := r.terminateRunnerSidecars(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			var pod corev1.Pod
+			require.NoError(t, r.Client.Get(context.TODO(), types.NamespacedName{Name: "sidecar-engine-runner", Namespace: "default"}, &pod))
+			if !mock.expectedDeadline {
+				if pod.Spec.ActiveDeadlineSeconds != nil {
+					t.Fatalf("Test %q failed: expected active deadline not to be set, got %v", name, *pod.Spec.ActiveDeadlineSeconds)
+				}
+				return
+			}
+			if pod.Spec.ActiveDeadlineSeconds == nil || *pod.Spec.ActiveDeadlineSeconds < 300 {
+				t.Fatalf("Test %q failed: expected active deadline to be set to the age of the pod, got %v", name, pod.Spec.ActiveDeadlineSeconds)
+			}
+		})
+	}
+}
+
+func TestUpdateEngineForError(t *testing.T) {
+	engine := chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine-error-p1",
+				Namespace: "default",
+				UID:       "fake-uid",
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				EngineState: v1alpha1.EngineStateActive,
+			},
+			Status: v1alpha1.ChaosEngineStatus{
+				EngineStatus: v1alpha1.EngineStatusInitialized,
+				Experiments: []v1alpha1.ExperimentStatuses{
+					{
+						Name:   "pod-delete",
+						Status: v1alpha1.ExperimentStatusRunning,
+					},
+				},
+			},
+		},
+	}
+	r := CreateFakeClient(t)
+	if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	for _, pod := range []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine-error-p1-runner",
+				Namespace: "default",
+				Labels:    map[string]string{"chaosUID": "fake-uid", "app.kubernetes.io/component": "chaos-runner"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod-delete-helper",
+				Namespace: "default",
+				Labels:    map[string]string{"chaosUID": "fake-uid"},
+			},
+		},
+	} {
+		if err := r.Client.Create(context.TODO(), pod); err != nil {
+			t.Fatalf("unable to create pod: %v", err)
+		}
+	}
+
+	if _, err := r.updateEngineForError(&engine, "ImagePullBackOff: fake-message"); err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+
+	updatedEngine := &v1alpha1.ChaosEngine{}
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "engine-error-p1", Namespace: "default"}, updatedEngine); err != nil {
+		t.Fatalf("unable to get engine: %v", err)
+	}
+	require.Equal(t, v1alpha1.EngineStatusError, updatedEngine.Status.EngineStatus)
+	require.Equal(t, v1alpha1.EngineStateStop, updatedEngine.Spec.EngineState)
+	require.Equal(t, v1alpha1.ExperimentStatusAborted, updatedEngine.Status.Experiments[0].Status)
+	require.True(t, meta.IsStatusConditionTrue(updatedEngine.Status.Conditions, v1alpha1.ChaosEngineConditionFailed))
+
+	podList := &corev1.PodList{}
+	if err := r.Client.List(context.TODO(), podList); err != nil {
+		t.Fatalf("unable to list pods: %v", err)
+	}
+	require.Equal(t, 1, len(podList.Items))
+	require.Equal(t, "engine-error-p1-runner", podList.Items[0].Name)
+}
+
+func TestReconcileForTimeout(t *testing.T) {
+	tests := map[string]struct {
+		activeDeadlineSeconds int64
+		runnerAge             time.Duration
+		isAborted             bool
+	}{
+		"Test Positive-1": {
+			activeDeadlineSeconds: 60,
+			runnerAge:             2 * time.Minute,
+			isAborted:             true,
+		},
+		"Test Positive-2": {
+			activeDeadlineSeconds: 600,
+			runnerAge:             2 * time.Minute,
+			isAborted:             false,
+		},
+		"Test Positive-3": {
+			runnerAge: 2 * time.Minute,
+			isAborted: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("WATCH_NAMESPACE", "default")
+			r := CreateFakeClient(t)
+			engine := chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "engine-timeout",
+						Namespace:  "default",
+						UID:        "fake-uid",
+						Finalizers: []string{finalizer},
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						EngineState:           v1alpha1.EngineStateActive,
+						ActiveDeadlineSeconds: mock.activeDeadlineSeconds,
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						EngineStatus: v1alpha1.EngineStatusInitialized,
+						Experiments: []v1alpha1.ExperimentStatuses{
+							{
+								Name:   "pod-delete",
+								Status: v1alpha1.ExperimentStatusRunning,
+							},
+						},
+					},
+				},
+			}
+			if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+				t.Fatalf("Test %q failed: unable to create engine: %v", name, err)
+			}
+			if err := r.Client.Create(context.TODO(), &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "engine-timeout-runner",
+					Namespace:         "default",
+					Labels:            map[string]string{"chaosUID": "fake-uid"},
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-mock.runnerAge)),
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			}); err != nil {
+				t.Fatalf("Test %q failed: unable to create runner pod: %v", name, err)
+			}
+
+			result, err := r.reconcileForCreationAndRunning(&engine, chaosTypes.Log)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			updatedEngine := &v1alpha1.ChaosEngine{}
+			if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "engine-timeout", Namespace: "default"}, updatedEngine); err != nil {
+				t.Fatalf("Test %q failed: unable to get engine: %v", name, err)
+			}
+			if !mock.isAborted {
+				require.Equal(t, v1alpha1.EngineStateActive, updatedEngine.Spec.EngineState)
+				if mock.activeDeadlineSeconds != 0 && result.RequeueAfter == 0 {
+					t.Fatalf("Test %q failed: expected the engine to be requeued at its deadline", name)
+				}
+				return
+			}
+			require.Equal(t, v1alpha1.EngineStateStop, updatedEngine.Spec.EngineState)
+			require.Equal(t, v1alpha1.EngineStatusStopping, updatedEngine.Status.EngineStatus)
+			if result.RequeueAfter == 0 {
+				t.Fatalf("Test %q failed: expected the stopping engine to be requeued", name)
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "engine-timeout", Namespace: "default"}}
+			if _, err := r.reconcileForDelete(&chaosTypes.EngineInfo{Instance: updatedEngine}, request); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "engine-timeout", Namespace: "default"}, updatedEngine); err != nil {
+				t.Fatalf("Test %q failed: unable to get engine: %v", name, err)
+			}
+			require.Equal(t, v1alpha1.EngineStatusStopped, updatedEngine.Status.EngineStatus)
+			require.Equal(t, v1alpha1.ExperimentStatusAborted, updatedEngine.Status.Experiments[0].Status)
+
+			condition := meta.FindStatusCondition(updatedEngine.Status.Conditions, v1alpha1.ChaosEngineConditionAborted)
+			if condition == nil || condition.Reason != "ActiveDeadlineExceeded" {
+				t.Fatalf("Test %q failed: expected the aborted condition with timeout reason, got %v", name, condition)
+			}
+
+			podList := &corev1.PodList{}
+			if err := r.Client.List(context.TODO(), podList); err != nil {
+				t.Fatalf("Test %q failed: unable to list pods: %v", name, err)
+			}
+			require.Equal(t, 0, len(podList.Items))
+		})
+	}
+}
+
+func TestGetEngineVerdict(t *testing.T) {
+	tests := map[string]struct {
+		verdicts        map[string]string
+		expectedVerdict v1alpha1.ResultVerdict
+	}{
+		"Test Positive-1": {
+			verdicts:        map[string]string{},
+			expectedVerdict: "",
+		},
+		"Test Positive-2": {
+			verdicts:        map[string]string{"pod-delete": "Pass", "pod-cpu-hog": "Pass"},
+			expectedVerdict: v1alpha1.ResultVerdictPassed,
+		},
+		"Test Positive-3": {
+			verdicts:        map[string]string{"pod-delete": "Pass"},
+			expectedVerdict: v1alpha1.ResultVerdictAwaited,
+		},
+		"Test Positive-4": {
+			verdicts:        map[string]string{"pod-delete": "Fail", "pod-cpu-hog": "Error"},
+			expectedVerdict: v1alpha1.ResultVerdictFailed,
+		},
+		"Test Positive-5": {
+			verdicts:        map[string]string{"pod-delete": "Pass", "pod-cpu-hog": "Stopped"},
+			expectedVerdict: v1alpha1.ResultVerdictStopped,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			instance := &v1alpha1.ChaosEngine{
+				Spec: v1alpha1.ChaosEngineSpec{
+					Experiments: []v1alpha1.ExperimentList{
+						{
+							Name: "pod-delete",
+						},
+						{
+							Name: "pod-cpu-hog",
+						},
+					},
+				},
+			}
+			for exp, verdict := range mock.verdicts {
+				instance.Status.Experiments = append(instance.Status.Experiments, v1alpha1.ExperimentStatuses{
+					Name:    exp,
+					Verdict: verdict,
+				})
+			}
+			require.Equal(t, mock.expectedVerdict, getEngineVerdict(instance))
+		})
+	}
+}
+
+func TestRecordExperimentVerdicts(t *testing.T) {
+	tests := map[string]struct {
+		previous      []v1alpha1.ExperimentStatuses
+		verdict       string
+		expectedCount float64
+	}{
+		"Test Positive-1": {
+			previous:      []v1alpha1.ExperimentStatuses{{Name: "pod-delete", Verdict: "Awaited"}},
+			verdict:       "Pass",
+			expectedCount: 1,
+		},
+		"Test Negative-1": {
+			previous:      []v1alpha1.ExperimentStatuses{{Name: "pod-delete", Verdict: "Pass"}},
+			verdict:       "Pass",
+			expectedCount: 0,
+		},
+		"Test Negative-2": {
+			verdict:       "Awaited",
+			expectedCount: 0,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			instance := &v1alpha1.ChaosEngine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      strings.ToLower(strings.ReplaceAll(name, " ", "-")),
+					Namespace: "default",
+				},
+				Status: v1alpha1.ChaosEngineStatus{
+					Experiments: []v1alpha1.ExperimentStatuses{
+						{
+							Name:    "pod-delete",
+							Verdict: mock.verdict,
+						},
+					},
+				},
+			}
+			recordExperimentVerdicts(instance, mock.previous)
+			count := testutil.ToFloat64(metrics.ExperimentVerdicts.WithLabelValues(instance.Namespace, instance.Name, "pod-delete", mock.verdict))
+			if count != mock.expectedCount {
+				t.Fatalf("Test %q failed: expected verdict count %v, received %v", name, mock.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestStopEngineForInvalidSpecMetrics(t *testing.T) {
+	// the namespace scoped operator skips the lookup of the chaosresult CRD
+	t.Setenv("WATCH_NAMESPACE", "invalid-spec")
+	r := CreateFakeClient(t)
+	engine := &v1alpha1.ChaosEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid-engine", Namespace: "invalid-spec", UID: "invalid-engine-uid"},
+		Spec:       v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive},
+		Status:     v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+	}
+	if err := r.Client.Create(context.TODO(), engine); err != nil {
+		t.Fatalf("unable to create chaosengine: %v", err)
+	}
+
+	if _, err := r.stopEngineForInvalidSpec(&chaosTypes.EngineInfo{Instance: engine}, "InvalidChaosEngine", fmt.Errorf("invalid chaosengine")); err == nil {
+		t.Fatalf("expected the error of the invalid spec to be returned")
+	}
+
+	// the next reconcile stops the chaosengine, whose engineState has been set to stop
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: engine.Name, Namespace: engine.Namespace}}
+	stopped := &v1alpha1.ChaosEngine{}
+	if err := r.Client.Get(context.TODO(), request.NamespacedName, stopped); err != nil {
+		t.Fatalf("unable to get chaosengine: %v", err)
+	}
+	require.Equal(t, v1alpha1.EngineStateStop, stopped.Spec.EngineState)
+	if _, err := r.reconcileForDelete(&chaosTypes.EngineInfo{Instance: stopped}, request); err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+
+	if err := r.Client.Get(context.TODO(), request.NamespacedName, stopped); err != nil {
+		t.Fatalf("unable to get chaosengine: %v", err)
+	}
+	require.Equal(t, v1alpha1.EngineStatusStopped, stopped.Status.EngineStatus)
+	require.True(t, meta.IsStatusConditionTrue(stopped.Status.Conditions, v1alpha1.ChaosEngineConditionFailed))
+	require.False(t, meta.IsStatusConditionTrue(stopped.Status.Conditions, v1alpha1.ChaosEngineConditionAborted))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.EnginesFailed.WithLabelValues(engine.Namespace, engine.Name)))
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.EnginesAborted.WithLabelValues(engine.Namespace, engine.Name)))
+}
+
+func TestSyncChaosResults(t *testing.T) {
+	r := CreateFakeClient(t)
+	engine := chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine-result",
+				Namespace: "default",
+				UID:       "fake-uid",
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				Experiments: []v1alpha1.ExperimentList{
+					{
+						Name: "pod-delete",
+					},
+				},
+			},
+			Status: v1alpha1.ChaosEngineStatus{
+				EngineStatus: v1alpha1.EngineStatusInitialized,
+				Experiments: []v1alpha1.ExperimentStatuses{
+					{
+						Name:    "pod-delete",
+						Runner:  "engine-result-runner",
+						Status:  v1alpha1.ExperimentStatusRunning,
+						Verdict: "Awaited",
+					},
+				},
+			},
+		},
+	}
+	if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	for _, result := range []*v1alpha1.ChaosResult{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "engine-result-pod-delete",
+				Namespace: "default",
+				Labels:    map[string]string{"chaosUID": "fake-uid"},
+			},
+			Spec: v1alpha1.ChaosResultSpec{
+				EngineName:     "engine-result",
+				ExperimentName: "pod-delete",
+			},
+			Status: v1alpha1.ChaosResultStatus{
+				ExperimentStatus: v1alpha1.TestStatus{
+					Phase:                  v1alpha1.ResultPhaseCompleted,
+					Verdict:                v1alpha1.ResultVerdictFailed,
+					ProbeSuccessPercentage: "50",
+					ErrorOutput: &v1alpha1.ErrorOutput{
+						ErrorCode: "CHAOS_INJECT_ERROR",
+						Reason:    "fake-reason",
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-engine-pod-delete",
+				Namespace: "default",
+				Labels:    map[string]string{"chaosUID": "other-uid"},
+			},
+			Spec: v1alpha1.ChaosResultSpec{
+				EngineName:     "other-engine",
+				ExperimentName: "pod-delete",
+			},
+			Status: v1alpha1.ChaosResultStatus{
+				ExperimentStatus: v1alpha1.TestStatus{
+					Phase:   v1alpha1.ResultPhaseCompleted,
+					Verdict: v1alpha1.ResultVerdictPassed,
+				},
+			},
+		},
+	} {
+		if err := r.Client.Create(context.TODO(), result); err != nil {
+			t.Fatalf("unable to create chaosresult: %v", err)
+		}
+	}
+
+	if err := r.syncChaosResults(&engine); err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+
+	updatedEngine := &v1alpha1.ChaosEngine{}
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "engine-result", Namespace: "default"}, updatedEngine); err != nil {
+		t.Fatalf("unable to get engine: %v", err)
+	}
+	require.Equal(t, v1alpha1.ResultVerdictFailed, updatedEngine.Status.Verdict)
+	require.Equal(t, 1, len(updatedEngine.Status.Experiments))
+
+	experiment := updatedEngine.Status.Experiments[0]
+	require.Equal(t, "engine-result-runner", experiment.Runner)
+	require.Equal(t, v1alpha1.ResultPhaseCompleted, experiment.Phase)
+	require.Equal(t, "Fail", experiment.Verdict)
+	require.Equal(t, "50", experiment.ProbeSuccessPercentage)
+	require.Equal(t, "fake-reason", experiment.ErrorOutput.Reason)
+}
+
 func TestEngineRunnerPod(t *testing.T) {
 	tests := map[string]struct {
 		isErr  bool
@@ -1613,7 +2696,7 @@ func TestReconcileForCreationAndRunning(t *testing.T) {
 				t.Fatalf("Test %q failed: expected error not to be nil", name)
 			}
 			if !mock.isErr && err != nil {
-				t.Fatalf("Test %q failed: expected error to be nil", name)
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
 			}
 		})
 	}
@@ -1646,14 +2729,16 @@ func CreateFakeClient(t *testing.T) *ChaosEngineReconciler {
 		Items: []v1alpha1.ChaosResult{},
 	}
 
-	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, engineR, chaosResultList, exp)
+	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, engineR, &v1alpha1.ChaosEngineList{}, &v1alpha1.ChaosBlackout{}, &v1alpha1.ChaosBlackoutList{}, &v1alpha1.ChaosPolicy{}, &v1alpha1.ChaosPolicyList{}, &v1alpha1.ChaosResult{}, chaosResultList, exp)
 
 	recorder := record.NewFakeRecorder(1024)
 
 	r := &ChaosEngineReconciler{
-		Client:   fakeClient,
-		Scheme:   s,
-		Recorder: recorder,
+		Client:           fakeClient,
+		APIReader:        fakeClient,
+		Scheme:           s,
+		Recorder:         recorder,
+		killSwitchReader: fakeClient,
 	}
 
 	return r
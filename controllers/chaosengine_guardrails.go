@@ -0,0 +1,281 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// blockedRequeueInterval is the interval after which a blocked chaosengine is evaluated again
+const blockedRequeueInterval = 30 * time.Second
+
+const (
+	// killSwitchName is the name of the configmap, inside the namespace of the operator, holding the kill switch
+	killSwitchName = "litmus-chaos-kill-switch"
+	// killSwitchKey is the key of the kill switch configmap, which engages the kill switch if set to true
+	killSwitchKey = "enabled"
+)
+
+// chaosAllowedLabel is the label opting a namespace in to be targeted by the chaos, if the opt-in is required
+const chaosAllowedLabel = "litmuschaos.io/chaos-allowed"
+
+// newKillSwitchCache returns a cache holding only the kill switch configmap, inside the namespace of the operator,
+// so that watching the kill switch doesn't cache all the configmaps of the cluster
+func newKillSwitchCache(mgr ctrl.Manager, operatorNamespace string) (cache.Cache, error) {
+	return cache.New(mgr.GetConfig(), cache.Options{
+		Scheme:    mgr.GetScheme(),
+		Mapper:    mgr.GetRESTMapper(),
+		Namespace: operatorNamespace,
+		SelectorsByObject: cache.SelectorsByObject{
+			&corev1.ConfigMap{}: {Field: fields.OneTermEqualSelector("metadata.name", killSwitchName)},
+		},
+	})
+}
+
+// isKillSwitchEngaged checks if the kill switch configmap, which halts all the chaos managed by the operator, is engaged
+func (r *ChaosEngineReconciler) isKillSwitchEngaged() (bool, error) {
+	if r.OperatorNamespace == "" {
+		return false, nil
+	}
+
+	var killSwitch corev1.ConfigMap
+	if err := r.killSwitchReader.Get(context.TODO(), types.NamespacedName{Name: killSwitchName, Namespace: r.OperatorNamespace}, &killSwitch); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to get kill switch configmap, due to error: %v", err)
+	}
+	return strings.EqualFold(killSwitch.Data[killSwitchKey], "true"), nil
+}
+
+// reconcileForKillSwitch halts the chaosengine while the kill switch is engaged. The active chaosengines are force
+// aborted, while the other chaosengines are held back with the Blocked condition until the kill switch is cleared
+func (r *ChaosEngineReconciler) reconcileForKillSwitch(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
+	message := fmt.Sprintf("kill switch %s/%s is engaged", r.OperatorNamespace, killSwitchName)
+
+	switch engine.Instance.Status.EngineStatus {
+	case litmuschaosv1alpha1.EngineStatusInitialized, litmuschaosv1alpha1.EngineStatusBlocked, litmuschaosv1alpha1.EngineStatusQueued:
+		return r.abortEngine(engine, request, "KillSwitchEngaged", message)
+	}
+
+	if err := r.patchEngineCondition(engine, litmuschaosv1alpha1.ChaosEngineConditionBlocked, v1.ConditionTrue, "KillSwitchEngaged", message); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: blockedRequeueInterval}, nil
+}
+
+// getEnginesForKillSwitch returns the requests for all the chaosengines, if the object is the kill switch configmap
+func (r *ChaosEngineReconciler) getEnginesForKillSwitch(obj client.Object) []reconcile.Request {
+	if obj.GetName() != killSwitchName || obj.GetNamespace() != r.OperatorNamespace {
+		return nil
+	}
+	return r.getAllEngineRequests()
+}
+
+// checkNamespaceOptIn checks that every namespace of the targets, and of the resolved target pods, carries the
+// chaos-allowed label set to true, so that the chaos only targets the namespaces which opted in for it
+func (r *ChaosEngineReconciler) checkNamespaceOptIn(engine *chaosTypes.EngineInfo, targets []chaosTypes.Target) error {
+	var namespaces []string
+	for _, target := range targets {
+		// the node targets are the only ones which aren't namespaced
+		if target.Kind == "node" {
+			continue
+		}
+		if target.Namespace == "" {
+			return fmt.Errorf("%w: %s target doesn't have a namespace", errNamespaceNotOptedIn, target.Kind)
+		}
+		namespaces = append(namespaces, target.Namespace)
+	}
+	if engine.Instance.Status.Targets != nil {
+		for _, pod := range engine.Instance.Status.Targets.Pods {
+			namespaces = append(namespaces, pod.Namespace)
+		}
+	}
+
+	checked := make(map[string]bool)
+	for _, ns := range namespaces {
+		if checked[ns] {
+			continue
+		}
+		checked[ns] = true
+
+		var namespace corev1.Namespace
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: ns}, &namespace); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return fmt.Errorf("%w: namespace %s doesn't exist", errNamespaceNotOptedIn, ns)
+			}
+			return fmt.Errorf("unable to get namespace, due to error: %v", err)
+		}
+		if namespace.Labels[chaosAllowedLabel] != "true" {
+			return fmt.Errorf("%w: namespace %s doesn't have the %s=true label", errNamespaceNotOptedIn, ns, chaosAllowedLabel)
+		}
+	}
+	return nil
+}
+
+// checkDisruptionBudgets checks that the disruption of the targeted pods doesn't break the poddisruptionbudgets covering
+// them, i.e. that the number of healthy targeted pods covered by a poddisruptionbudget doesn't exceed its allowed disruptions
+func (r *ChaosEngineReconciler) checkDisruptionBudgets(engine *chaosTypes.EngineInfo) error {
+	targets := engine.Instance.Status.Targets
+	if targets == nil {
+		return nil
+	}
+
+	var namespaces []string
+	targetedPods := make(map[string]map[string]bool)
+	for _, pod := range targets.Pods {
+		if targetedPods[pod.Namespace] == nil {
+			namespaces = append(namespaces, pod.Namespace)
+			targetedPods[pod.Namespace] = make(map[string]bool)
+		}
+		targetedPods[pod.Namespace][pod.Name] = true
+	}
+
+	for _, ns := range namespaces {
+		pdbList := &policyv1.PodDisruptionBudgetList{}
+		if err := r.APIReader.List(context.TODO(), pdbList, client.InNamespace(ns)); err != nil {
+			return fmt.Errorf("unable to list poddisruptionbudgets, due to error: %v", err)
+		}
+		if len(pdbList.Items) == 0 {
+			continue
+		}
+
+		podList := &corev1.PodList{}
+		if err := r.Client.List(context.TODO(), podList, client.InNamespace(ns)); err != nil {
+			return fmt.Errorf("unable to list pods, due to error: %v", err)
+		}
+
+		for _, pdb := range pdbList.Items {
+			// a poddisruptionbudget without selector doesn't select any pod
+			if pdb.Spec.Selector == nil {
+				continue
+			}
+			selector, err := v1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil {
+				continue
+			}
+
+			disrupted := 0
+			for _, pod := range podList.Items {
+				if targetedPods[ns][pod.Name] && selector.Matches(labels.Set(pod.Labels)) && isPodHealthy(&pod) {
+					disrupted++
+				}
+			}
+			if disrupted > int(pdb.Status.DisruptionsAllowed) {
+				return fmt.Errorf("%w: %d healthy pods covered by poddisruptionbudget %s/%s are targeted, while its %s only allows %d disruptions",
+					errDisruptionBudgetExceeded, disrupted, ns, pdb.Name, getDisruptionBudgetLimit(&pdb), pdb.Status.DisruptionsAllowed)
+			}
+		}
+	}
+	return nil
+}
+
+// getDisruptionBudgetLimit describes the minAvailable or maxUnavailable of the poddisruptionbudget
+func getDisruptionBudgetLimit(pdb *policyv1.PodDisruptionBudget) string {
+	if pdb.Spec.MinAvailable != nil {
+		return "minAvailable " + pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		return "maxUnavailable " + pdb.Spec.MaxUnavailable.String()
+	}
+	return "budget"
+}
+
+// isPodHealthy checks if the pod is ready and not being deleted, as counted by the poddisruptionbudgets
+func isPodHealthy(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// blockEngine holds the chaosengine in the blocked state, recording the reason in its conditions,
+// and requeues it so that the guardrails are evaluated again
+func (r *ChaosEngineReconciler) blockEngine(engine *chaosTypes.EngineInfo, reason string, err error) (reconcile.Result, error) {
+	return r.holdEngine(engine, litmuschaosv1alpha1.EngineStatusBlocked, litmuschaosv1alpha1.ChaosEngineConditionBlocked, reason, err, 0)
+}
+
+// unblockEngine moves the blocked chaosengine back to the initialized state and clears its Blocked condition,
+// once the guardrails are passed
+func (r *ChaosEngineReconciler) unblockEngine(engine *chaosTypes.EngineInfo) error {
+	return r.resumeEngine(engine, litmuschaosv1alpha1.EngineStatusBlocked, litmuschaosv1alpha1.ChaosEngineConditionBlocked, "GuardrailsPassed", "ChaosEngine is no longer blocked")
+}
+
+// holdEngine holds the chaosengine in the given state, recording the reason in the given condition along with its
+// position in the queue of the chaosengines, and requeues it so that it is evaluated again
+func (r *ChaosEngineReconciler) holdEngine(engine *chaosTypes.EngineInfo, status litmuschaosv1alpha1.EngineStatus, conditionType, reason string, err error, queuePosition int) (reconcile.Result, error) {
+	condition := meta.FindStatusCondition(engine.Instance.Status.Conditions, conditionType)
+	if condition == nil || condition.Status != v1.ConditionTrue || condition.Reason != reason || condition.Message != err.Error() {
+		r.Recorder.Eventf(engine.Instance, corev1.EventTypeWarning, reason, "%v", err)
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	engine.Instance.Status.EngineStatus = status
+	engine.Instance.Status.QueuePosition = queuePosition
+	setEngineCondition(engine.Instance, conditionType, v1.ConditionTrue, reason, err.Error())
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("unable to patch status of chaosEngine Resource, due to error: %v", err)
+	}
+	return reconcile.Result{RequeueAfter: blockedRequeueInterval}, nil
+}
+
+// resumeEngine moves the chaosengine held in the given state back to the initialized state, and clears the given
+// condition along with its position in the queue of the chaosengines
+func (r *ChaosEngineReconciler) resumeEngine(engine *chaosTypes.EngineInfo, status litmuschaosv1alpha1.EngineStatus, conditionType, reason, message string) error {
+	if engine.Instance.Status.EngineStatus != status && !meta.IsStatusConditionTrue(engine.Instance.Status.Conditions, conditionType) {
+		return nil
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	if engine.Instance.Status.EngineStatus == status {
+		engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
+	}
+	engine.Instance.Status.QueuePosition = 0
+	setEngineCondition(engine.Instance, conditionType, v1.ConditionFalse, reason, message)
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch status of chaosEngine Resource, due to error: %v", err)
+	}
+	return nil
+}
+
+// isEngineHeld checks if the launch of the chaos-runner is held back, i.e. the chaosengine is blocked or queued
+func isEngineHeld(status litmuschaosv1alpha1.EngineStatus) bool {
+	return status == litmuschaosv1alpha1.EngineStatusBlocked || status == litmuschaosv1alpha1.EngineStatusQueued
+}
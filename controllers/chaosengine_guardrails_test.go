@@ -0,0 +1,282 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestCheckDisruptionBudgets(t *testing.T) {
+	minAvailable := intstr.FromInt(2)
+	tests := map[string]struct {
+		targetedPods       []string
+		disruptionsAllowed int32
+		isErr              bool
+	}{
+		"Test Positive-1": {
+			targetedPods:       []string{"frontend-1"},
+			disruptionsAllowed: 1,
+		},
+		"Test Positive-2": {
+			// the unhealthy pods don't count against the poddisruptionbudget
+			targetedPods:       []string{"frontend-1", "frontend-3"},
+			disruptionsAllowed: 1,
+		},
+		"Test Negative-1": {
+			targetedPods:       []string{"frontend-1", "frontend-2"},
+			disruptionsAllowed: 1,
+			isErr:              true,
+		},
+		"Test Negative-2": {
+			targetedPods:       []string{"frontend-1"},
+			disruptionsAllowed: 0,
+			isErr:              true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			pdb := &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "shop"},
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					MinAvailable: &minAvailable,
+					Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}},
+				},
+			}
+			objects := []client.Object{pdb}
+			for i := 1; i <= 3; i++ {
+				ready := corev1.ConditionTrue
+				if i == 3 {
+					ready = corev1.ConditionFalse
+				}
+				objects = append(objects, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("frontend-%d", i), Namespace: "shop", Labels: map[string]string{"app": "frontend"}},
+					Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}},
+				})
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+			pdb.Status.DisruptionsAllowed = mock.disruptionsAllowed
+			if err := r.Client.Status().Update(context.TODO(), pdb); err != nil {
+				t.Fatalf("Test %q failed: unable to update poddisruptionbudget: %v", name, err)
+			}
+
+			targets := &v1alpha1.TargetsStatus{}
+			for _, podName := range mock.targetedPods {
+				targets.Pods = append(targets.Pods, v1alpha1.ResolvedTarget{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: podName})
+			}
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+					Status:     v1alpha1.ChaosEngineStatus{Targets: targets},
+				},
+			}
+			err := r.checkDisruptionBudgets(engine)
+			if mock.isErr {
+				if !errors.Is(err, errDisruptionBudgetExceeded) {
+					t.Fatalf("Test %q failed: expected poddisruptionbudget error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+		})
+	}
+}
+
+func TestBlockEngine(t *testing.T) {
+	r := CreateFakeClient(t)
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+			Status:     v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+		},
+	}
+	if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("unable to create chaosengine: %v", err)
+	}
+
+	result, err := r.blockEngine(engine, "DisruptionBudgetExceeded", fmt.Errorf("%w: test", errDisruptionBudgetExceeded))
+	require.NoError(t, err)
+	require.Equal(t, blockedRequeueInterval, result.RequeueAfter)
+
+	blocked := &v1alpha1.ChaosEngine{}
+	require.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(engine.Instance), blocked))
+	require.Equal(t, v1alpha1.EngineStatusBlocked, blocked.Status.EngineStatus)
+	require.True(t, meta.IsStatusConditionTrue(blocked.Status.Conditions, v1alpha1.ChaosEngineConditionBlocked))
+
+	require.NoError(t, r.unblockEngine(engine))
+	unblocked := &v1alpha1.ChaosEngine{}
+	require.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(engine.Instance), unblocked))
+	require.Equal(t, v1alpha1.EngineStatusInitialized, unblocked.Status.EngineStatus)
+	require.True(t, meta.IsStatusConditionFalse(unblocked.Status.Conditions, v1alpha1.ChaosEngineConditionBlocked))
+}
+
+func TestIsKillSwitchEngaged(t *testing.T) {
+	tests := map[string]struct {
+		killSwitch        *corev1.ConfigMap
+		operatorNamespace string
+		isEngaged         bool
+	}{
+		"Test Positive-1": {
+			killSwitch:        &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: killSwitchName, Namespace: "litmus"}, Data: map[string]string{killSwitchKey: "true"}},
+			operatorNamespace: "litmus",
+			isEngaged:         true,
+		},
+		"Test Negative-1": {
+			killSwitch:        &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: killSwitchName, Namespace: "litmus"}, Data: map[string]string{killSwitchKey: "false"}},
+			operatorNamespace: "litmus",
+		},
+		"Test Negative-2": {
+			killSwitch:        &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: killSwitchName, Namespace: "default"}, Data: map[string]string{killSwitchKey: "true"}},
+			operatorNamespace: "litmus",
+		},
+		"Test Negative-3": {
+			operatorNamespace: "litmus",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			r.OperatorNamespace = mock.operatorNamespace
+			if mock.killSwitch != nil {
+				if err := r.Client.Create(context.TODO(), mock.killSwitch); err != nil {
+					t.Fatalf("Test %q failed: unable to create kill switch: %v", name, err)
+				}
+			}
+
+			isEngaged, err := r.isKillSwitchEngaged()
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Equal(t, mock.isEngaged, isEngaged)
+		})
+	}
+}
+
+func TestReconcileForKillSwitch(t *testing.T) {
+	r := CreateFakeClient(t)
+	r.OperatorNamespace = "litmus"
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+			Spec:       v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive},
+		},
+	}
+	if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("unable to create chaosengine: %v", err)
+	}
+
+	result, err := r.reconcileForKillSwitch(engine, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(engine.Instance)})
+	require.NoError(t, err)
+	require.Equal(t, blockedRequeueInterval, result.RequeueAfter)
+
+	held := &v1alpha1.ChaosEngine{}
+	require.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(engine.Instance), held))
+	require.Empty(t, held.Status.EngineStatus)
+	condition := meta.FindStatusCondition(held.Status.Conditions, v1alpha1.ChaosEngineConditionBlocked)
+	require.NotNil(t, condition)
+	require.Equal(t, "KillSwitchEngaged", condition.Reason)
+
+	require.Len(t, r.getEnginesForKillSwitch(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: killSwitchName, Namespace: "litmus"}}), 1)
+	require.Empty(t, r.getEnginesForKillSwitch(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "engine-run-context", Namespace: "shop"}}))
+}
+
+func TestCheckNamespaceOptIn(t *testing.T) {
+	tests := map[string]struct {
+		targets      []chaosTypes.Target
+		resolvedPods []v1alpha1.ResolvedTarget
+		isErr        bool
+	}{
+		"Test Positive-1": {
+			targets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop"},
+				{Kind: "pod", Namespace: "shop"},
+				{Kind: "node", Names: []string{"worker-1"}},
+			},
+		},
+		"Test Negative-1": {
+			targets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop"},
+				{Kind: "deployment", Namespace: "payments"},
+			},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			targets: []chaosTypes.Target{{Kind: "statefulset", Namespace: "billing"}},
+			isErr:   true,
+		},
+		"Test Negative-3": {
+			targets: []chaosTypes.Target{{Kind: "deployment", Namespace: "missing"}},
+			isErr:   true,
+		},
+		"Test Negative-4": {
+			// the workload target without namespace isn't skipped like the node targets
+			targets: []chaosTypes.Target{{Kind: "deployment", Labels: "app=nginx"}},
+			isErr:   true,
+		},
+		"Test Negative-5": {
+			// the namespaces of the resolved pods are checked as well
+			targets:      []chaosTypes.Target{{Kind: "deployment", Namespace: "shop"}},
+			resolvedPods: []v1alpha1.ResolvedTarget{{Kind: "Pod", Namespace: "payments", Name: "checkout-1"}},
+			isErr:        true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			for _, ns := range []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "shop", Labels: map[string]string{chaosAllowedLabel: "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "payments"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "billing", Labels: map[string]string{chaosAllowedLabel: "false"}}},
+			} {
+				if err := r.Client.Create(context.TODO(), ns); err != nil {
+					t.Fatalf("Test %q failed: unable to create namespace: %v", name, err)
+				}
+			}
+
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+					Status:     v1alpha1.ChaosEngineStatus{Targets: &v1alpha1.TargetsStatus{Pods: mock.resolvedPods}},
+				},
+			}
+			err := r.checkNamespaceOptIn(engine, mock.targets)
+			if mock.isErr && !errors.Is(err, errNamespaceNotOptedIn) {
+				t.Fatalf("Test %q failed: expected namespace not opted in error, got %v", name, err)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// lockTargetAnnotation is the annotation of the lock holding the target it locks
+	lockTargetAnnotation = "litmuschaos.io/target"
+	// lockLabel is the label of the locks taken by the chaosengines
+	lockLabel = "litmuschaos.io/target-lock"
+)
+
+// getLockNamespace returns the namespace of the locks, which is the namespace of the operator, so that the
+// chaosengines of all the namespaces share the same locks, or the namespace of the chaosengine otherwise
+func (r *ChaosEngineReconciler) getLockNamespace(engine *chaosTypes.EngineInfo) string {
+	if r.OperatorNamespace != "" {
+		return r.OperatorNamespace
+	}
+	return engine.Instance.Namespace
+}
+
+// getLockTargets returns the targets locked by the chaosengine, i.e. its resolved workloads and nodes,
+// or its resolved pods if they aren't owned by any workload
+func getLockTargets(targets *litmuschaosv1alpha1.TargetsStatus) []litmuschaosv1alpha1.ResolvedTarget {
+	if targets == nil {
+		return nil
+	}
+	lockTargets := append(append([]litmuschaosv1alpha1.ResolvedTarget{}, targets.Workloads...), targets.Nodes...)
+	if len(lockTargets) == 0 {
+		return targets.Pods
+	}
+	return lockTargets
+}
+
+// getLockName returns the name of the lease locking the target, derived from its kind, namespace and name
+func getLockName(target litmuschaosv1alpha1.ResolvedTarget) string {
+	sum := sha256.Sum256([]byte(getLockTarget(target)))
+	return fmt.Sprintf("litmus-lock-%x", sum[:8])
+}
+
+// getLockTarget describes the target locked by a lease
+func getLockTarget(target litmuschaosv1alpha1.ResolvedTarget) string {
+	return strings.Join([]string{target.Kind, target.Namespace, target.Name}, "/")
+}
+
+// getLockHolder returns the holder identity of the locks taken by the chaosengine
+func getLockHolder(engine *chaosTypes.EngineInfo) string {
+	return engine.Instance.Namespace + "/" + engine.Instance.Name
+}
+
+// acquireTargetLocks takes a lease for each of the resolved targets of the chaosengine. The leases held by another
+// active chaosengine aren't taken over, in which case the locks taken so far are released and an error naming the
+// holder of the lock is returned
+func (r *ChaosEngineReconciler) acquireTargetLocks(engine *chaosTypes.EngineInfo) error {
+	namespace := r.getLockNamespace(engine)
+	holder := getLockHolder(engine)
+
+	for _, target := range getLockTargets(engine.Instance.Status.Targets) {
+		lease := &coordinationv1.Lease{}
+		err := r.APIReader.Get(context.TODO(), types.NamespacedName{Name: getLockName(target), Namespace: namespace}, lease)
+		switch {
+		case k8serrors.IsNotFound(err):
+			lease = &coordinationv1.Lease{
+				ObjectMeta: v1.ObjectMeta{
+					Name:        getLockName(target),
+					Namespace:   namespace,
+					Labels:      map[string]string{lockLabel: "true", "chaosUID": string(engine.Instance.UID)},
+					Annotations: map[string]string{lockTargetAnnotation: getLockTarget(target)},
+				},
+				Spec: coordinationv1.LeaseSpec{HolderIdentity: &holder, AcquireTime: &v1.MicroTime{Time: v1.Now().Time}},
+			}
+			if err := r.Client.Create(context.TODO(), lease); err != nil {
+				if k8serrors.IsAlreadyExists(err) {
+					r.releaseTargetLocks(engine)
+					return fmt.Errorf("%w: %s is being locked by another chaosengine", errTargetLocked, getLockTarget(target))
+				}
+				return fmt.Errorf("unable to create lock of %s, due to error: %v", getLockTarget(target), err)
+			}
+			continue
+		case err != nil:
+			return fmt.Errorf("unable to get lock of %s, due to error: %v", getLockTarget(target), err)
+		}
+
+		if lease.Labels["chaosUID"] == string(engine.Instance.UID) {
+			continue
+		}
+		isHeld, err := r.isLockHeld(lease)
+		if err != nil {
+			return err
+		}
+		if isHeld {
+			r.releaseTargetLocks(engine)
+			return fmt.Errorf("%w: %s is locked by chaosengine %s", errTargetLocked, getLockTarget(target), *lease.Spec.HolderIdentity)
+		}
+
+		// take over the lock left over by a chaosengine which isn't active anymore
+		lease.Labels = map[string]string{lockLabel: "true", "chaosUID": string(engine.Instance.UID)}
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.AcquireTime = &v1.MicroTime{Time: v1.Now().Time}
+		if err := r.Client.Update(context.TODO(), lease); err != nil {
+			if k8serrors.IsConflict(err) {
+				r.releaseTargetLocks(engine)
+				return fmt.Errorf("%w: %s is being locked by another chaosengine", errTargetLocked, getLockTarget(target))
+			}
+			return fmt.Errorf("unable to update lock of %s, due to error: %v", getLockTarget(target), err)
+		}
+	}
+	return nil
+}
+
+// isLockHeld checks if the holder of the lease is still active, i.e. the chaosengine holding it still
+// exists and is neither completed nor stopped
+func (r *ChaosEngineReconciler) isLockHeld(lease *coordinationv1.Lease) (bool, error) {
+	if lease.Spec.HolderIdentity == nil {
+		return false, nil
+	}
+	namespace, name, found := strings.Cut(*lease.Spec.HolderIdentity, "/")
+	if !found {
+		return false, nil
+	}
+
+	holder := &litmuschaosv1alpha1.ChaosEngine{}
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, holder); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to get chaosengine %s holding the lock, due to error: %v", *lease.Spec.HolderIdentity, err)
+	}
+	if string(holder.UID) != lease.Labels["chaosUID"] {
+		return false, nil
+	}
+
+	// the chaosengine holds its locks while it is running, and while its chaos pods are being terminated
+	switch holder.Status.EngineStatus {
+	case litmuschaosv1alpha1.EngineStatusInitialized:
+		return holder.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive, nil
+	case litmuschaosv1alpha1.EngineStatusStopping:
+		return true, nil
+	}
+	return false, nil
+}
+
+// releaseTargetLocks deletes the leases held by the chaosengine. The leases which can't be deleted are
+// taken over by the other chaosengines, once the chaosengine isn't active anymore
+func (r *ChaosEngineReconciler) releaseTargetLocks(engine *chaosTypes.EngineInfo) {
+	if err := r.Client.DeleteAllOf(context.TODO(), &coordinationv1.Lease{}, client.InNamespace(r.getLockNamespace(engine)),
+		client.MatchingLabels{lockLabel: "true", "chaosUID": string(engine.Instance.UID)}); err != nil {
+		chaosTypes.Log.Error(err, "unable to release the locks of chaos targets", "chaosengine", engine.Instance.Name)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAcquireTargetLocks(t *testing.T) {
+	frontend := v1alpha1.ResolvedTarget{Kind: "Deployment", APIVersion: "apps/v1", Namespace: "shop", Name: "frontend"}
+	cart := v1alpha1.ResolvedTarget{Kind: "Deployment", APIVersion: "apps/v1", Namespace: "shop", Name: "cart"}
+	newEngine := func(name, uid string, targets ...v1alpha1.ResolvedTarget) *chaosTypes.EngineInfo {
+		return &chaosTypes.EngineInfo{
+			Instance: &v1alpha1.ChaosEngine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop", UID: types.UID("uid-" + uid)},
+				Spec:       v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive},
+				Status: v1alpha1.ChaosEngineStatus{
+					EngineStatus: v1alpha1.EngineStatusInitialized,
+					Targets:      &v1alpha1.TargetsStatus{Workloads: targets},
+				},
+			},
+		}
+	}
+
+	r := CreateFakeClient(t)
+	r.OperatorNamespace = "litmus"
+	holder := newEngine("engine-a", "a", frontend)
+	waiter := newEngine("engine-b", "b", cart, frontend)
+	for _, engine := range []*chaosTypes.EngineInfo{holder, waiter} {
+		if err := r.Client.Create(context.TODO(), engine.Instance); err != nil {
+			t.Fatalf("unable to create chaosengine: %v", err)
+		}
+	}
+
+	require.NoError(t, r.acquireTargetLocks(holder))
+	// the locks are reentrant for their holder
+	require.NoError(t, r.acquireTargetLocks(holder))
+
+	err := r.acquireTargetLocks(waiter)
+	if !errors.Is(err, errTargetLocked) || !strings.Contains(err.Error(), "shop/engine-a") {
+		t.Fatalf("expected the lock to be held by shop/engine-a, got %v", err)
+	}
+	// the locks taken by the queued chaosengine are released
+	leaseList := &coordinationv1.LeaseList{}
+	require.NoError(t, r.Client.List(context.TODO(), leaseList, client.InNamespace("litmus")))
+	require.Len(t, leaseList.Items, 1)
+
+	// the locks of the chaosengines which aren't active anymore are taken over
+	holder.Instance.Status.EngineStatus = v1alpha1.EngineStatusCompleted
+	require.NoError(t, r.Client.Update(context.TODO(), holder.Instance))
+	require.NoError(t, r.acquireTargetLocks(waiter))
+
+	r.releaseTargetLocks(waiter)
+	require.NoError(t, r.Client.List(context.TODO(), leaseList, client.InNamespace("litmus")))
+	require.Empty(t, leaseList.Items)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkChaosPolicies evaluates the chaospolicies applying to the namespace of the chaosengine, and records their
+// violations inside the chaosengine status
+func (r *ChaosEngineReconciler) checkChaosPolicies(engine *chaosTypes.EngineInfo) error {
+	violations, err := r.getPolicyViolations(engine)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(violations, engine.Instance.Status.PolicyViolations) {
+		patch := client.MergeFrom(engine.Instance.DeepCopy())
+		engine.Instance.Status.PolicyViolations = violations
+		if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to patch policy violations of chaosEngine Resource, due to error: %v", err)
+		}
+	}
+
+	if len(violations) != 0 {
+		return fmt.Errorf("%w: %s", errPolicyViolated, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// getPolicyViolations returns the violations of the chaospolicies applying to the namespace of the chaosengine.
+// A chaospolicy which can't be evaluated is reported as violated, so that the chaos isn't launched unchecked
+func (r *ChaosEngineReconciler) getPolicyViolations(engine *chaosTypes.EngineInfo) ([]string, error) {
+	policyList := &litmuschaosv1alpha1.ChaosPolicyList{}
+	if err := r.Client.List(context.TODO(), policyList); err != nil {
+		return nil, fmt.Errorf("unable to list chaospolicies, due to error: %v", err)
+	}
+	if len(policyList.Items) == 0 {
+		return nil, nil
+	}
+
+	var namespace corev1.Namespace
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: engine.Instance.Namespace}, &namespace); err != nil && !k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to get namespace, due to error: %v", err)
+	}
+
+	experimentList, _, err := r.getChaosExperiments(engine)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get chaosexperiments, due to error: %v", err)
+	}
+	experiments := make(map[string]*litmuschaosv1alpha1.ChaosExperiment)
+	for i := range experimentList {
+		experiments[experimentList[i].Name] = &experimentList[i]
+	}
+
+	var violations []string
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		applies, err := policy.AppliesTo(namespace.Labels)
+		if err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		if !applies {
+			continue
+		}
+		for _, violation := range policy.Evaluate(engine.Instance, experiments) {
+			violations = append(violations, violation.Error())
+		}
+	}
+	return violations, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCheckChaosPolicies(t *testing.T) {
+	tests := map[string]struct {
+		policies   []v1alpha1.ChaosPolicySpec
+		violations int
+	}{
+		"Test Positive-1": {},
+		"Test Positive-2": {
+			// the policy of the other team doesn't apply to the chaosengine
+			policies: []v1alpha1.ChaosPolicySpec{
+				{
+					NamespaceSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+					AllowedExperiments: []string{"pod-delete"},
+				},
+			},
+		},
+		"Test Negative-1": {
+			policies: []v1alpha1.ChaosPolicySpec{
+				{
+					NamespaceSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "shop"}},
+					AllowedExperiments: []string{"pod-delete"},
+					AllowHostPID:       true,
+				},
+			},
+			violations: 1,
+		},
+		"Test Negative-2": {
+			// the hostPID of the experiment and its image violate the policy
+			policies: []v1alpha1.ChaosPolicySpec{
+				{AllowedImageRegistries: []string{"docker.io/litmuschaos"}},
+			},
+			violations: 2,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			objects := []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop", Labels: map[string]string{"team": "shop"}}},
+				&v1alpha1.ChaosExperiment{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-io-stress", Namespace: "shop"},
+					Spec:       v1alpha1.ChaosExperimentSpec{Definition: v1alpha1.ExperimentDef{Image: "quay.io/chaos/go-runner:latest", HostPID: true}},
+				},
+			}
+			for i, spec := range mock.policies {
+				objects = append(objects, &v1alpha1.ChaosPolicy{ObjectMeta: metav1.ObjectMeta{Name: "policy-" + string(rune('a'+i))}, Spec: spec})
+			}
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Components:  v1alpha1.ComponentParams{Runner: v1alpha1.RunnerInfo{Image: "litmuschaos/chaos-runner:latest"}},
+						Experiments: []v1alpha1.ExperimentList{{Name: "node-io-stress"}},
+					},
+				},
+			}
+			objects = append(objects, engine.Instance)
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			err := r.checkChaosPolicies(engine)
+			if mock.violations == 0 && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if mock.violations != 0 && !errors.Is(err, errPolicyViolated) {
+				t.Fatalf("Test %q failed: expected chaospolicy violated error, got %v", name, err)
+			}
+
+			updated := &v1alpha1.ChaosEngine{}
+			require.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(engine.Instance), updated))
+			require.Len(t, updated.Status.PolicyViolations, mock.violations)
+		})
+	}
+}
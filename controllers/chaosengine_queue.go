@@ -0,0 +1,152 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxActiveEnginesAnnotation is the annotation of the namespaces overriding the maximum number of their active chaosengines
+const maxActiveEnginesAnnotation = "litmuschaos.io/max-active-engines"
+
+// concurrencyLimitReason is the reason of the Queued condition of the chaosengines waiting for the concurrency limits
+const concurrencyLimitReason = "ConcurrencyLimitReached"
+
+// isEngineActive checks if the chaosengine counts against the concurrency limits, i.e.
+// its chaos-runner is launched or its chaos pods are being terminated
+func isEngineActive(engine *litmuschaosv1alpha1.ChaosEngine) bool {
+	switch engine.Status.EngineStatus {
+	case litmuschaosv1alpha1.EngineStatusInitialized:
+		return meta.IsStatusConditionTrue(engine.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionRunnerScheduled)
+	case litmuschaosv1alpha1.EngineStatusStopping:
+		return true
+	}
+	return false
+}
+
+// isEngineWaiting checks if the chaosengine waits for the concurrency limits, i.e. it isn't launched yet
+// or it is queued because of the concurrency limits
+func isEngineWaiting(engine *litmuschaosv1alpha1.ChaosEngine) bool {
+	if engine.Spec.EngineState != litmuschaosv1alpha1.EngineStateActive {
+		return false
+	}
+	switch engine.Status.EngineStatus {
+	case litmuschaosv1alpha1.EngineStatusInitialized:
+		return !meta.IsStatusConditionTrue(engine.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionRunnerScheduled)
+	case litmuschaosv1alpha1.EngineStatusQueued:
+		condition := meta.FindStatusCondition(engine.Status.Conditions, litmuschaosv1alpha1.ChaosEngineConditionQueued)
+		return condition != nil && condition.Reason == concurrencyLimitReason
+	}
+	return false
+}
+
+// getMaxActiveEngines returns the maximum number of active chaosengines in the namespace, taken from the
+// annotation of the namespace if present, or from the namespace-level limit of the operator otherwise
+func (r *ChaosEngineReconciler) getMaxActiveEngines(namespace string) (int, error) {
+	var ns corev1.Namespace
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: namespace}, &ns); err != nil {
+		return 0, fmt.Errorf("unable to get namespace %s, due to error: %v", namespace, err)
+	}
+	value, ok := ns.Annotations[maxActiveEnginesAnnotation]
+	if !ok {
+		return r.MaxActiveEnginesPerNamespace, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation of namespace %s: %v", maxActiveEnginesAnnotation, namespace, err)
+	}
+	return limit, nil
+}
+
+// getQueuePosition admits the waiting chaosengines, ordered by their priority and creation time, as long as the
+// operator-level and namespace-level limits of active chaosengines allow it. It returns 0 if the chaosengine is
+// admitted, or its position amongst the chaosengines which aren't admitted, along with the limit holding it back.
+// The chaosengines are listed from the API server, as the cache may not hold the chaosengines admitted lately yet
+func (r *ChaosEngineReconciler) getQueuePosition(engine *chaosTypes.EngineInfo) (int, string, error) {
+	engineList := &litmuschaosv1alpha1.ChaosEngineList{}
+	if err := r.APIReader.List(context.TODO(), engineList); err != nil {
+		return 0, "", fmt.Errorf("unable to list chaosengines, due to error: %v", err)
+	}
+
+	active := 0
+	activePerNamespace := make(map[string]int)
+	waiting := []litmuschaosv1alpha1.ChaosEngine{*engine.Instance}
+	for _, e := range engineList.Items {
+		switch {
+		case e.UID == engine.Instance.UID:
+		case isEngineActive(&e):
+			active++
+			activePerNamespace[e.Namespace]++
+		case isEngineWaiting(&e):
+			waiting = append(waiting, e)
+		}
+	}
+
+	sort.SliceStable(waiting, func(i, j int) bool {
+		if waiting[i].Spec.Priority != waiting[j].Spec.Priority {
+			return waiting[i].Spec.Priority > waiting[j].Spec.Priority
+		}
+		if !waiting[i].CreationTimestamp.Equal(&waiting[j].CreationTimestamp) {
+			return waiting[i].CreationTimestamp.Before(&waiting[j].CreationTimestamp)
+		}
+		return waiting[i].Namespace+"/"+waiting[i].Name < waiting[j].Namespace+"/"+waiting[j].Name
+	})
+
+	maxActivePerNamespace := make(map[string]int)
+	position := 0
+	for _, e := range waiting {
+		maxActive, ok := maxActivePerNamespace[e.Namespace]
+		if !ok {
+			var err error
+			if maxActive, err = r.getMaxActiveEngines(e.Namespace); err != nil {
+				return 0, "", err
+			}
+			maxActivePerNamespace[e.Namespace] = maxActive
+		}
+
+		var limit string
+		switch {
+		case r.MaxActiveEngines > 0 && active >= r.MaxActiveEngines:
+			limit = fmt.Sprintf("the operator allows %d active chaosengines", r.MaxActiveEngines)
+		case maxActive > 0 && activePerNamespace[e.Namespace] >= maxActive:
+			limit = fmt.Sprintf("namespace %s allows %d active chaosengines", e.Namespace, maxActive)
+		}
+
+		if limit == "" {
+			if e.UID == engine.Instance.UID {
+				return 0, "", nil
+			}
+			active++
+			activePerNamespace[e.Namespace]++
+			continue
+		}
+		position++
+		if e.UID == engine.Instance.UID {
+			return position, limit, nil
+		}
+	}
+	return 0, "", nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetQueuePosition(t *testing.T) {
+	created := time.Now()
+	newEngine := func(namespace, name string, priority int32, age time.Duration, isActive bool) *v1alpha1.ChaosEngine {
+		engine := &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				UID:               types.UID(namespace + "-" + name),
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec:   v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive, Priority: priority},
+			Status: v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+		}
+		if isActive {
+			setEngineCondition(engine, v1alpha1.ChaosEngineConditionRunnerScheduled, metav1.ConditionTrue, "RunnerPodCreated", "chaos-runner pod is created")
+		}
+		return engine
+	}
+
+	tests := map[string]struct {
+		maxActive             int
+		maxActivePerNamespace int
+		engine                string
+		expectedPosition      int
+	}{
+		"Test Positive-1": {
+			maxActive:        3,
+			engine:           "staging/high",
+			expectedPosition: 0,
+		},
+		"Test Positive-2": {
+			maxActive:        3,
+			engine:           "staging/old",
+			expectedPosition: 1,
+		},
+		"Test Positive-3": {
+			maxActive:        3,
+			engine:           "staging/new",
+			expectedPosition: 2,
+		},
+		"Test Positive-4": {
+			maxActivePerNamespace: 2,
+			engine:                "staging/high",
+			expectedPosition:      0,
+		},
+		"Test Positive-5": {
+			maxActivePerNamespace: 2,
+			engine:                "staging/old",
+			expectedPosition:      1,
+		},
+		"Test Positive-6": {
+			// the namespace annotation overrides the namespace-level limit
+			maxActivePerNamespace: 2,
+			engine:                "batch/report",
+			expectedPosition:      3,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			r.MaxActiveEngines = mock.maxActive
+			r.MaxActiveEnginesPerNamespace = mock.maxActivePerNamespace
+
+			engines := map[string]*v1alpha1.ChaosEngine{
+				"staging/running": newEngine("staging", "running", 0, time.Hour, true),
+				"batch/running":   newEngine("batch", "running", 0, time.Hour, true),
+				"staging/high":    newEngine("staging", "high", 10, time.Second, false),
+				"staging/old":     newEngine("staging", "old", 0, time.Minute, false),
+				"staging/new":     newEngine("staging", "new", 0, time.Second, false),
+				"batch/report":    newEngine("batch", "report", 0, 0, false),
+			}
+			objects := []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "staging"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "batch", Annotations: map[string]string{maxActiveEnginesAnnotation: "1"}}},
+			}
+			for _, engine := range engines {
+				objects = append(objects, engine)
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			position, _, err := r.getQueuePosition(&chaosTypes.EngineInfo{Instance: engines[mock.engine]})
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Equal(t, mock.expectedPosition, position)
+		})
+	}
+}
+
+func TestGetQueuePositionWithStaleCache(t *testing.T) {
+	r := CreateFakeClient(t)
+	r.MaxActiveEngines = 1
+
+	engine := &v1alpha1.ChaosEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: "waiting", Namespace: "staging", UID: "staging-waiting"},
+		Spec:       v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive},
+		Status:     v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+	}
+	// the chaosengine admitted lately isn't present inside the cache yet
+	admitted := &v1alpha1.ChaosEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: "admitted", Namespace: "staging", UID: "staging-admitted"},
+		Spec:       v1alpha1.ChaosEngineSpec{EngineState: v1alpha1.EngineStateActive},
+		Status:     v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+	}
+	setEngineCondition(admitted, v1alpha1.ChaosEngineConditionRunnerScheduled, metav1.ConditionTrue, "RunnerPodCreated", "chaos-runner pod is created")
+
+	require.NoError(t, r.Client.Create(context.TODO(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "staging"}}))
+	require.NoError(t, r.Client.Create(context.TODO(), engine.DeepCopy()))
+	r.APIReader = fake.NewClientBuilder().WithObjects(engine.DeepCopy(), admitted).Build()
+
+	position, _, err := r.getQueuePosition(&chaosTypes.EngineInfo{Instance: engine})
+	require.NoError(t, err)
+	require.Equal(t, 1, position)
+}
@@ -0,0 +1,769 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// workloadGVKs maps the supported workload kinds to their group, version and kind
+var workloadGVKs = map[string]schema.GroupVersionKind{
+	"deployment":       {Group: "apps", Version: "v1", Kind: "Deployment"},
+	"statefulset":      {Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	"daemonset":        {Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	"deploymentconfig": {Group: "apps.openshift.io", Version: "v1", Kind: "DeploymentConfig"},
+	"rollout":          {Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+	"job":              {Group: "batch", Version: "v1", Kind: "Job"},
+	"cronjob":          {Group: "batch", Version: "v1", Kind: "CronJob"},
+	"replicaset":       {Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+}
+
+// targetsRefreshInterval is the minimum interval between the refreshes of the resolved targets of a running chaosengine
+const targetsRefreshInterval = time.Minute
+
+// controlPlaneLabels contains the role labels of the control-plane nodes
+var controlPlaneLabels = []string{
+	"node-role.kubernetes.io/control-plane",
+	"node-role.kubernetes.io/master",
+}
+
+// getTargets derives the targets of the chaos from the chaosengine. The workload, pod and node selectors
+// are combined, so that the chaos targets the union of the selected workloads, pods and nodes, while the
+// appinfo is only used if the selectors are not provided. The selectors are validated and the
+// namespace selectors are resolved into the matching namespaces
+func (r *ChaosEngineReconciler) getTargets(engine *chaosTypes.EngineInfo) ([]chaosTypes.Target, error) {
+	if engine.Selectors == nil && reflect.DeepEqual(engine.AppInfo, litmuschaosv1alpha1.ApplicationParams{}) {
+		return nil, nil
+	}
+
+	var targets []chaosTypes.Target
+
+	if engine.Selectors != nil {
+		for i, w := range engine.Selectors.Workloads {
+			workloadTargets, err := r.getWorkloadTargets(w)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve selectors.workloads[%d], due to error: %w", i, err)
+			}
+			targets = append(targets, workloadTargets...)
+		}
+
+		for _, p := range engine.Selectors.Pods {
+			targets = append(targets, chaosTypes.Target{
+				Kind:      "pod",
+				Namespace: p.Namespace,
+				Names:     splitNames(p.Names),
+			})
+		}
+
+		for i, n := range engine.Selectors.Nodes {
+			nodeTarget, err := r.getNodeTarget(n)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve selectors.nodes[%d], due to error: %w", i, err)
+			}
+			targets = append(targets, nodeTarget)
+		}
+		return targets, nil
+	}
+
+	if engine.AppInfo.Appns == "" {
+		engine.AppInfo.Appns = engine.Instance.Namespace
+	}
+
+	if engine.AppInfo.AppKind == "" {
+		engine.AppInfo.AppKind = litmuschaosv1alpha1.AppKindAny
+	}
+	return append(targets, chaosTypes.Target{
+		Kind:      engine.AppInfo.AppKind,
+		Namespace: engine.AppInfo.Appns,
+		Labels:    engine.AppInfo.Applabel,
+		IsAppInfo: true,
+	}), nil
+}
+
+// getWorkloadTargets derives the targets of the workload selector, one for each of the
+// namespaces matching its namespace selector, or its namespace otherwise. The pods of the
+// workload kinds which the chaos-runner can't resolve by itself are resolved into the targets
+func (r *ChaosEngineReconciler) getWorkloadTargets(w litmuschaosv1alpha1.Workload) ([]chaosTypes.Target, error) {
+	targets, err := r.getWorkloadSelectorTargets(w)
+	if err != nil || !isPodResolvedKind(w) {
+		return targets, err
+	}
+
+	for i := range targets {
+		pods, err := r.getTargetPods(w, targets[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(pods) == 0 {
+			return nil, fmt.Errorf("%w: %s in namespace %s doesn't have any pods", errInvalidSelector, w.Kind, targets[i].Namespace)
+		}
+		for _, pod := range pods {
+			targets[i].Pods = append(targets[i].Pods, pod.Name)
+		}
+	}
+	return targets, nil
+}
+
+// getWorkloadSelectorTargets derives the targets of the workload selector from its selectors
+func (r *ChaosEngineReconciler) getWorkloadSelectorTargets(w litmuschaosv1alpha1.Workload) ([]chaosTypes.Target, error) {
+	labelSelector := w.Labels
+	if w.LabelSelector != nil {
+		selector, err := w.GetLabelSelector()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidSelector, err)
+		}
+		labelSelector = selector.String()
+	}
+
+	fieldSelector, err := w.GetFieldSelector()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSelector, err)
+	}
+
+	namespaces := []string{w.Namespace}
+	namespaceSelector, err := w.GetNamespaceSelector()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSelector, err)
+	}
+	// an empty namespace would select the pods of every namespace of the cluster
+	if w.Namespace == "" && namespaceSelector == nil {
+		return nil, fmt.Errorf("%w: specify one out of namespace or namespaceSelector", errInvalidSelector)
+	}
+	if namespaceSelector != nil {
+		if namespaces, err = r.getNamespaces(namespaceSelector); err != nil {
+			return nil, err
+		}
+		if len(namespaces) == 0 {
+			return nil, fmt.Errorf("%w: namespaceSelector %q doesn't match any namespace", errInvalidSelector, namespaceSelector.String())
+		}
+	}
+
+	var targets []chaosTypes.Target
+	for _, ns := range namespaces {
+		targets = append(targets, chaosTypes.Target{
+			Kind:          string(w.Kind),
+			APIVersion:    w.APIVersion,
+			Namespace:     ns,
+			Names:         splitNames(w.Names),
+			Labels:        labelSelector,
+			FieldSelector: fieldSelector.String(),
+		})
+	}
+	return targets, nil
+}
+
+// isPodResolvedKind checks if the pods of the workload are resolved by the operator, which is the case for
+// the jobs, cronjobs, replicasets and the generic kinds selected through their apiVersion
+func isPodResolvedKind(w litmuschaosv1alpha1.Workload) bool {
+	if w.APIVersion != "" {
+		return true
+	}
+	switch litmuschaosv1alpha1.WorkloadKind(strings.ToLower(string(w.Kind))) {
+	case litmuschaosv1alpha1.WorkloadJob, litmuschaosv1alpha1.WorkloadCronJob, litmuschaosv1alpha1.WorkloadReplicaSet:
+		return true
+	}
+	return false
+}
+
+// getNamespaces returns the names of the namespaces matching the selector
+func (r *ChaosEngineReconciler) getNamespaces(selector labels.Selector) ([]string, error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.Client.List(context.TODO(), namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list namespaces, due to error: %v", err)
+	}
+
+	var namespaces []string
+	for _, ns := range namespaceList.Items {
+		namespaces = append(namespaces, ns.Name)
+	}
+	return namespaces, nil
+}
+
+// splitNames splits the comma separated names of the selector
+func splitNames(names string) []string {
+	var result []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// getNodeTarget resolves the node selector into the names of the target nodes, checking that the
+// nodes exist and that they are not control-plane nodes, unless they are explicitly allowed
+func (r *ChaosEngineReconciler) getNodeTarget(n litmuschaosv1alpha1.Node) (chaosTypes.Target, error) {
+	var nodes []corev1.Node
+
+	switch {
+	case n.Names != "":
+		for _, name := range splitNames(n.Names) {
+			var node corev1.Node
+			if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: name}, &node); err != nil {
+				if k8serrors.IsNotFound(err) {
+					return chaosTypes.Target{}, fmt.Errorf("%w: node %s doesn't exist", errInvalidSelector, name)
+				}
+				return chaosTypes.Target{}, fmt.Errorf("unable to get node %s, due to error: %v", name, err)
+			}
+			nodes = append(nodes, node)
+		}
+	case n.LabelSelector != nil:
+		selector, err := v1.LabelSelectorAsSelector(n.LabelSelector)
+		if err != nil {
+			return chaosTypes.Target{}, fmt.Errorf("%w: invalid labelSelector: %v", errInvalidSelector, err)
+		}
+		nodeList := &corev1.NodeList{}
+		if err := r.Client.List(context.TODO(), nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return chaosTypes.Target{}, fmt.Errorf("unable to list nodes, due to error: %v", err)
+		}
+		nodes = nodeList.Items
+	case n.Workload != nil:
+		hostNodes, err := r.getWorkloadNodes(*n.Workload)
+		if err != nil {
+			return chaosTypes.Target{}, err
+		}
+		nodes = hostNodes
+	default:
+		return chaosTypes.Target{}, fmt.Errorf("%w: specify one out of names, labelSelector or workload", errInvalidSelector)
+	}
+
+	if len(nodes) == 0 {
+		return chaosTypes.Target{}, fmt.Errorf("%w: no nodes match the selector", errInvalidSelector)
+	}
+
+	target := chaosTypes.Target{Kind: "node"}
+	for _, node := range nodes {
+		if isControlPlaneNode(&node) && !n.AllowControlPlane {
+			return chaosTypes.Target{}, fmt.Errorf("%w: node %s is a control-plane node, set allowControlPlane to target it", errInvalidSelector, node.Name)
+		}
+		target.Names = append(target.Names, node.Name)
+	}
+	return target, nil
+}
+
+// getWorkloadNodes returns the nodes hosting the pods of the workload
+func (r *ChaosEngineReconciler) getWorkloadNodes(w litmuschaosv1alpha1.Workload) ([]corev1.Node, error) {
+	pods, err := r.getWorkloadPods(w)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []corev1.Node
+	hosts := make(map[string]bool)
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || hosts[pod.Spec.NodeName] {
+			continue
+		}
+		hosts[pod.Spec.NodeName] = true
+
+		var node corev1.Node
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: pod.Spec.NodeName}, &node); err != nil {
+			return nil, fmt.Errorf("unable to get node %s, due to error: %v", pod.Spec.NodeName, err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// getWorkloadPods returns the pods of the workloads selected by the names or the labels of the workload,
+// resolved through the owner references of the pods
+func (r *ChaosEngineReconciler) getWorkloadPods(w litmuschaosv1alpha1.Workload) ([]corev1.Pod, error) {
+	targets, err := r.getWorkloadSelectorTargets(w)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	for _, target := range targets {
+		targetPods, err := r.getTargetPods(w, target)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, targetPods...)
+	}
+	return pods, nil
+}
+
+// getTargetPods returns the pods owned by the workloads of the target, matching its field selector
+func (r *ChaosEngineReconciler) getTargetPods(w litmuschaosv1alpha1.Workload, target chaosTypes.Target) ([]corev1.Pod, error) {
+	fieldSelector, err := fields.ParseSelector(target.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSelector, err)
+	}
+
+	owners, err := r.getTargetWorkloads(w, target)
+	if err != nil || len(owners) == 0 {
+		return nil, err
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.Client.List(context.TODO(), podList, client.InNamespace(target.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list pods, due to error: %v", err)
+	}
+
+	var pods []corev1.Pod
+	ownerRefs := make(map[types.UID][]v1.OwnerReference)
+	for _, pod := range podList.Items {
+		if !fieldSelector.Matches(getPodFields(&pod)) {
+			continue
+		}
+		isOwned, err := r.isOwnedBy(pod.Namespace, pod.OwnerReferences, owners, ownerRefs, 0)
+		if err != nil {
+			return nil, err
+		}
+		if isOwned {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// getLabeledPods returns the pods of the namespace of the target matching its labels and field selector
+func (r *ChaosEngineReconciler) getLabeledPods(target chaosTypes.Target) ([]corev1.Pod, error) {
+	selector, err := labels.Parse(target.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid labels %q: %v", errInvalidSelector, target.Labels, err)
+	}
+	fieldSelector, err := fields.ParseSelector(target.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSelector, err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.Client.List(context.TODO(), podList, client.InNamespace(target.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list pods, due to error: %v", err)
+	}
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if fieldSelector.Matches(getPodFields(&pod)) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// getAppInfoPods returns the pods selected by the applabel of the appinfo target, which is a label of the pods
+// rather than of their workloads, keeping only the pods owned by a workload of the appkind, unless the appkind
+// is the KIND wildcard or a kind unknown to the operator
+func (r *ChaosEngineReconciler) getAppInfoPods(target chaosTypes.Target, ownerRefs map[types.UID][]v1.OwnerReference) ([]corev1.Pod, error) {
+	pods, err := r.getLabeledPods(target)
+	if err != nil || strings.EqualFold(target.Kind, litmuschaosv1alpha1.AppKindAny) {
+		return pods, err
+	}
+	gvk, err := getWorkloadGVK(litmuschaosv1alpha1.Workload{Kind: litmuschaosv1alpha1.WorkloadKind(target.Kind)})
+	if err != nil {
+		return pods, nil
+	}
+
+	var ownedPods []corev1.Pod
+	for _, pod := range pods {
+		isOwned, err := r.isOwnedByKind(pod.Namespace, pod.OwnerReferences, gvk.Kind, ownerRefs)
+		if err != nil {
+			return nil, err
+		}
+		if isOwned {
+			ownedPods = append(ownedPods, pod)
+		}
+	}
+	return ownedPods, nil
+}
+
+// getTargetWorkloads returns the uids of the workloads of the target's kind, selected by the names of the target
+// or, if it doesn't have any names, by its labels
+func (r *ChaosEngineReconciler) getTargetWorkloads(w litmuschaosv1alpha1.Workload, target chaosTypes.Target) ([]types.UID, error) {
+	gvk, err := getWorkloadGVK(w)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners []types.UID
+	if len(target.Names) != 0 {
+		for _, name := range target.Names {
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(gvk)
+			if err := r.APIReader.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: target.Namespace}, workload); err != nil {
+				if k8serrors.IsNotFound(err) {
+					return nil, fmt.Errorf("%w: %s %s/%s doesn't exist", errInvalidSelector, w.Kind, target.Namespace, name)
+				}
+				return nil, fmt.Errorf("unable to get %s %s/%s, due to error: %v", w.Kind, target.Namespace, name, err)
+			}
+			owners = append(owners, workload.GetUID())
+		}
+		return owners, nil
+	}
+
+	selector, err := labels.Parse(target.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid labels %q: %v", errInvalidSelector, target.Labels, err)
+	}
+	workloadList := &unstructured.UnstructuredList{}
+	workloadList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.APIReader.List(context.TODO(), workloadList, client.InNamespace(target.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list %s, due to error: %v", w.Kind, err)
+	}
+	for _, workload := range workloadList.Items {
+		owners = append(owners, workload.GetUID())
+	}
+	return owners, nil
+}
+
+// maxOwnerDepth is the maximum depth of the owner references followed from a pod to its workload,
+// such as pod -> replicaset -> deployment -> knative revision -> knative configuration -> knative service
+const maxOwnerDepth = 8
+
+// isOwnedBy checks if any of the owners is reachable from the owner references, by following the owner
+// references of the owners. The owner references of the fetched owners are cached inside ownerRefs
+func (r *ChaosEngineReconciler) isOwnedBy(namespace string, refs []v1.OwnerReference, owners []types.UID, ownerRefs map[types.UID][]v1.OwnerReference, depth int) (bool, error) {
+	if depth >= maxOwnerDepth {
+		return false, nil
+	}
+
+	for _, ref := range refs {
+		for _, owner := range owners {
+			if ref.UID == owner {
+				return true, nil
+			}
+		}
+
+		parentRefs, err := r.getOwnerReferences(namespace, ref, ownerRefs)
+		if err != nil {
+			return false, err
+		}
+
+		isOwned, err := r.isOwnedBy(namespace, parentRefs, owners, ownerRefs, depth+1)
+		if err != nil || isOwned {
+			return isOwned, err
+		}
+	}
+	return false, nil
+}
+
+// getOwnerReferences returns the owner references of the owner, fetching the owner unless its
+// owner references are already cached inside ownerRefs
+func (r *ChaosEngineReconciler) getOwnerReferences(namespace string, ref v1.OwnerReference, ownerRefs map[types.UID][]v1.OwnerReference) ([]v1.OwnerReference, error) {
+	if refs, ok := ownerRefs[ref.UID]; ok {
+		return refs, nil
+	}
+
+	owner := &unstructured.Unstructured{}
+	owner.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	// the walk stops at the owners which are gone or whose kind the operator isn't allowed to read
+	if err := r.APIReader.Get(context.TODO(), types.NamespacedName{Name: ref.Name, Namespace: namespace}, owner); err != nil && !k8serrors.IsNotFound(err) && !k8serrors.IsForbidden(err) {
+		return nil, fmt.Errorf("unable to get owner %s %s/%s, due to error: %v", ref.Kind, namespace, ref.Name, err)
+	}
+	ownerRefs[ref.UID] = owner.GetOwnerReferences()
+	return ownerRefs[ref.UID], nil
+}
+
+// getRootOwner follows the controller references up to the top-level owner, such as the deployment
+// of a pod, returning nil if the object isn't owned by any workload
+func (r *ChaosEngineReconciler) getRootOwner(namespace string, refs []v1.OwnerReference, ownerRefs map[types.UID][]v1.OwnerReference) (*v1.OwnerReference, error) {
+	var root *v1.OwnerReference
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		ref := getControllerRef(refs)
+		if ref == nil {
+			break
+		}
+		root = ref
+
+		var err error
+		if refs, err = r.getOwnerReferences(namespace, *ref, ownerRefs); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// isOwnedByKind checks if any of the owners reached by following the controller references is of the kind
+func (r *ChaosEngineReconciler) isOwnedByKind(namespace string, refs []v1.OwnerReference, kind string, ownerRefs map[types.UID][]v1.OwnerReference) (bool, error) {
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		ref := getControllerRef(refs)
+		if ref == nil {
+			return false, nil
+		}
+		if ref.Kind == kind {
+			return true, nil
+		}
+
+		var err error
+		if refs, err = r.getOwnerReferences(namespace, *ref, ownerRefs); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// getControllerRef returns the controller reference out of the owner references,
+// falling back to the first owner reference if none of them is marked as controller
+func getControllerRef(refs []v1.OwnerReference) *v1.OwnerReference {
+	if len(refs) == 0 {
+		return nil
+	}
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	return &refs[0]
+}
+
+// getWorkloadGVK returns the group, version and kind of the workload, derived from its apiVersion
+// and kind for the generic kinds, or from the supported workload kinds otherwise
+func getWorkloadGVK(w litmuschaosv1alpha1.Workload) (schema.GroupVersionKind, error) {
+	if w.APIVersion != "" {
+		gv, err := schema.ParseGroupVersion(w.APIVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, fmt.Errorf("%w: invalid apiVersion %q: %v", errInvalidSelector, w.APIVersion, err)
+		}
+		return gv.WithKind(string(w.Kind)), nil
+	}
+
+	gvk, ok := workloadGVKs[strings.ToLower(string(w.Kind))]
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf("%w: unsupported workload kind %s", errInvalidSelector, w.Kind)
+	}
+	return gvk, nil
+}
+
+// getPodFields returns the fields of the pod supported by the field selectors
+func getPodFields(pod *corev1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":            pod.Name,
+		"metadata.namespace":       pod.Namespace,
+		"spec.nodeName":            pod.Spec.NodeName,
+		"spec.restartPolicy":       string(pod.Spec.RestartPolicy),
+		"spec.schedulerName":       pod.Spec.SchedulerName,
+		"spec.serviceAccountName":  pod.Spec.ServiceAccountName,
+		"spec.hostNetwork":         strconv.FormatBool(pod.Spec.HostNetwork),
+		"status.phase":             string(pod.Status.Phase),
+		"status.podIP":             pod.Status.PodIP,
+		"status.nominatedNodeName": pod.Status.NominatedNodeName,
+	}
+}
+
+// isControlPlaneNode checks if the node is a control-plane node, based on its role labels
+func isControlPlaneNode(node *corev1.Node) bool {
+	for _, label := range controlPlaneLabels {
+		if _, ok := node.Labels[label]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getTargetsStatus resolves the targets of the chaos into the workloads, pods and nodes they select. The workloads
+// are either the named workloads, or the top-level owners of the pods selected through the labels of the workloads
+// or through the applabel of the appinfo
+func (r *ChaosEngineReconciler) getTargetsStatus(targets []chaosTypes.Target) (*litmuschaosv1alpha1.TargetsStatus, error) {
+	status := &litmuschaosv1alpha1.TargetsStatus{}
+	seen := make(map[litmuschaosv1alpha1.ResolvedTarget]bool)
+	add := func(list *[]litmuschaosv1alpha1.ResolvedTarget, target litmuschaosv1alpha1.ResolvedTarget) {
+		if !seen[target] {
+			seen[target] = true
+			*list = append(*list, target)
+		}
+	}
+
+	ownerRefs := make(map[types.UID][]v1.OwnerReference)
+	for _, target := range targets {
+		switch target.Kind {
+		case "node":
+			for _, name := range target.Names {
+				add(&status.Nodes, litmuschaosv1alpha1.ResolvedTarget{Kind: "Node", APIVersion: "v1", Name: name})
+			}
+		case "pod":
+			for _, name := range target.Names {
+				var pod corev1.Pod
+				if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: target.Namespace}, &pod); err != nil {
+					if k8serrors.IsNotFound(err) {
+						continue
+					}
+					return nil, fmt.Errorf("unable to get pod %s/%s, due to error: %v", target.Namespace, name, err)
+				}
+				add(&status.Pods, litmuschaosv1alpha1.ResolvedTarget{Kind: "Pod", APIVersion: "v1", Namespace: pod.Namespace, Name: pod.Name})
+
+				owner, err := r.getRootOwner(pod.Namespace, pod.OwnerReferences, ownerRefs)
+				if err != nil {
+					return nil, err
+				}
+				if owner != nil {
+					add(&status.Workloads, litmuschaosv1alpha1.ResolvedTarget{Kind: owner.Kind, APIVersion: owner.APIVersion, Namespace: pod.Namespace, Name: owner.Name})
+				}
+			}
+		default:
+			w := litmuschaosv1alpha1.Workload{Kind: litmuschaosv1alpha1.WorkloadKind(target.Kind), APIVersion: target.APIVersion}
+			var pods []corev1.Pod
+			var err error
+			_, gvkErr := getWorkloadGVK(w)
+			switch {
+			case target.IsAppInfo:
+				pods, err = r.getAppInfoPods(target, ownerRefs)
+			case gvkErr != nil && len(target.Names) == 0:
+				// the kinds unknown to the operator are resolved by the chaos-runner,
+				// so the preview lists the pods matching the labels
+				pods, err = r.getLabeledPods(target)
+			default:
+				pods, err = r.getTargetPods(w, target)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if len(target.Names) != 0 {
+				gvk, err := getWorkloadGVK(w)
+				if err != nil {
+					return nil, err
+				}
+				for _, name := range target.Names {
+					add(&status.Workloads, litmuschaosv1alpha1.ResolvedTarget{Kind: gvk.Kind, APIVersion: gvk.GroupVersion().String(), Namespace: target.Namespace, Name: name})
+				}
+			}
+
+			for _, pod := range pods {
+				add(&status.Pods, litmuschaosv1alpha1.ResolvedTarget{Kind: "Pod", APIVersion: "v1", Namespace: pod.Namespace, Name: pod.Name})
+				if len(target.Names) != 0 {
+					continue
+				}
+				owner, err := r.getRootOwner(pod.Namespace, pod.OwnerReferences, ownerRefs)
+				if err != nil {
+					return nil, err
+				}
+				if owner != nil {
+					add(&status.Workloads, litmuschaosv1alpha1.ResolvedTarget{Kind: owner.Kind, APIVersion: owner.APIVersion, Namespace: pod.Namespace, Name: owner.Name})
+				}
+			}
+		}
+	}
+
+	for _, list := range [][]litmuschaosv1alpha1.ResolvedTarget{status.Workloads, status.Pods, status.Nodes} {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Namespace != list[j].Namespace {
+				return list[i].Namespace < list[j].Namespace
+			}
+			if list[i].Kind != list[j].Kind {
+				return list[i].Kind < list[j].Kind
+			}
+			return list[i].Name < list[j].Name
+		})
+	}
+	status.Count = len(status.Pods) + len(status.Nodes)
+	return status, nil
+}
+
+// updateTargetsStatus resolves the targets of the chaos and records them in the status of the
+// chaosengine, along with the time at which they were resolved
+func (r *ChaosEngineReconciler) updateTargetsStatus(engine *chaosTypes.EngineInfo, targets []chaosTypes.Target) error {
+	status, err := r.getTargetsStatus(targets)
+	if err != nil {
+		return err
+	}
+	status.LastUpdateTime = v1.Now()
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	engine.Instance.Status.Targets = status
+	if err := r.Client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to patch the targets of chaosengine, due to error: %v", err)
+	}
+	return nil
+}
+
+// isTargetsRefreshDue checks if the resolved targets of the running chaosengine are older than the targetsRefreshInterval
+func isTargetsRefreshDue(engine *chaosTypes.EngineInfo) bool {
+	targets := engine.Instance.Status.Targets
+	return targets == nil || time.Since(targets.LastUpdateTime.Time) >= targetsRefreshInterval
+}
+
+// checkBlastRadius checks the resolved targets of the chaos against the blast-radius limits of the chaosengine, i.e.
+// the maximum number of targeted pods and nodes, and the maximum percentage of the pods in each of the target
+// namespaces and of the nodes in the cluster
+func (r *ChaosEngineReconciler) checkBlastRadius(engine *chaosTypes.EngineInfo) error {
+	spec := engine.Instance.Spec
+	targets := engine.Instance.Status.Targets
+	if targets == nil {
+		return nil
+	}
+
+	if spec.MaxTargetCount > 0 && targets.Count > spec.MaxTargetCount {
+		return fmt.Errorf("%w: %d pods and nodes are targeted, exceeding the maxTargetCount of %d", errBlastRadiusExceeded, targets.Count, spec.MaxTargetCount)
+	}
+	if spec.MaxTargetPercentage <= 0 {
+		return nil
+	}
+
+	var namespaces []string
+	targetedPods := make(map[string]int)
+	for _, pod := range targets.Pods {
+		if targetedPods[pod.Namespace] == 0 {
+			namespaces = append(namespaces, pod.Namespace)
+		}
+		targetedPods[pod.Namespace]++
+	}
+	for _, ns := range namespaces {
+		podList := &corev1.PodList{}
+		if err := r.Client.List(context.TODO(), podList, client.InNamespace(ns)); err != nil {
+			return fmt.Errorf("unable to list pods, due to error: %v", err)
+		}
+		if exceedsPercentage(targetedPods[ns], len(podList.Items), spec.MaxTargetPercentage) {
+			return fmt.Errorf("%w: %d out of %d pods in namespace %s are targeted, exceeding the maxTargetPercentage of %d%%", errBlastRadiusExceeded, targetedPods[ns], len(podList.Items), ns, spec.MaxTargetPercentage)
+		}
+	}
+
+	if len(targets.Nodes) != 0 {
+		nodeList := &corev1.NodeList{}
+		if err := r.Client.List(context.TODO(), nodeList); err != nil {
+			return fmt.Errorf("unable to list nodes, due to error: %v", err)
+		}
+		if exceedsPercentage(len(targets.Nodes), len(nodeList.Items), spec.MaxTargetPercentage) {
+			return fmt.Errorf("%w: %d out of %d nodes are targeted, exceeding the maxTargetPercentage of %d%%", errBlastRadiusExceeded, len(targets.Nodes), len(nodeList.Items), spec.MaxTargetPercentage)
+		}
+	}
+	return nil
+}
+
+// exceedsPercentage checks if the count exceeds the percentage of the total
+func exceedsPercentage(count, total, percentage int) bool {
+	return total > 0 && count*100 > total*percentage
+}
+
+// getTargetsENV returns the JSON encoded targets passed to the chaos-runner
+func getTargetsENV(targets []chaosTypes.Target) string {
+	if len(targets) == 0 {
+		return ""
+	}
+	// the targets only consist of strings, which are always encoded
+	data, _ := json.Marshal(targets)
+	return string(data)
+}
@@ -0,0 +1,591 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// forbiddenKindReader denies the reads of the objects of the given kind, as the apiserver
+// does for the kinds which the ClusterRole of the operator doesn't grant
+type forbiddenKindReader struct {
+	client.Reader
+	kind string
+}
+
+func (f forbiddenKindReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if obj.GetObjectKind().GroupVersionKind().Kind == f.kind {
+		return k8serrors.NewForbidden(schema.GroupResource{Resource: f.kind}, key.Name, errors.New("access denied"))
+	}
+	return f.Reader.Get(ctx, key, obj, opts...)
+}
+
+func TestGetTargets(t *testing.T) {
+	tests := map[string]struct {
+		appInfo         v1alpha1.ApplicationParams
+		selectors       *v1alpha1.Selector
+		expectedTargets []chaosTypes.Target
+		isErr           bool
+	}{
+		"Test Positive-1": {
+			appInfo: v1alpha1.ApplicationParams{
+				Applabel: "app=nginx",
+				AppKind:  "deployment",
+			},
+			expectedTargets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "default", Labels: "app=nginx", IsAppInfo: true},
+			},
+		},
+		"Test Positive-2": {
+			selectors: &v1alpha1.Selector{
+				Workloads: []v1alpha1.Workload{
+					{Kind: v1alpha1.WorkloadDeployment, Namespace: "shop", Names: "frontend, cart"},
+					{Kind: v1alpha1.WorkloadStatefulSet, Namespace: "db", Labels: "app=mysql"},
+				},
+				Pods: []v1alpha1.Pod{
+					{Namespace: "shop", Names: "payment-0"},
+				},
+			},
+			expectedTargets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop", Names: []string{"frontend", "cart"}},
+				{Kind: "statefulset", Namespace: "db", Labels: "app=mysql"},
+				{Kind: "pod", Namespace: "shop", Names: []string{"payment-0"}},
+			},
+		},
+		"Test Positive-3": {
+			expectedTargets: nil,
+		},
+		"Test Positive-4": {
+			selectors: &v1alpha1.Selector{
+				Workloads: []v1alpha1.Workload{
+					{
+						Kind:   v1alpha1.WorkloadDeployment,
+						Labels: "app=nginx",
+						LabelSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"frontend", "backend"}},
+							},
+						},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "staging"},
+						},
+						FieldSelector: "spec.nodeName=node-1",
+					},
+				},
+			},
+			expectedTargets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "staging-a", Labels: "app=nginx,tier in (backend,frontend)", FieldSelector: "spec.nodeName=node-1"},
+				{Kind: "deployment", Namespace: "staging-b", Labels: "app=nginx,tier in (backend,frontend)", FieldSelector: "spec.nodeName=node-1"},
+			},
+		},
+		"Test Negative-1": {
+			selectors: &v1alpha1.Selector{
+				Workloads: []v1alpha1.Workload{
+					{Kind: v1alpha1.WorkloadDeployment, Namespace: "shop", Labels: "app=nginx", FieldSelector: "spec.containers=nginx"},
+				},
+			},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			selectors: &v1alpha1.Selector{
+				Workloads: []v1alpha1.Workload{
+					{
+						Kind:              v1alpha1.WorkloadDeployment,
+						Labels:            "app=nginx",
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "production"}},
+					},
+				},
+			},
+			isErr: true,
+		},
+		"Test Negative-3": {
+			// neither namespace nor namespaceSelector, which would target the pods of every namespace
+			selectors: &v1alpha1.Selector{
+				Workloads: []v1alpha1.Workload{
+					{Kind: v1alpha1.WorkloadDeployment, Labels: "app=nginx"},
+				},
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			for _, ns := range []string{"staging-a", "staging-b"} {
+				namespace := &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   ns,
+						Labels: map[string]string{"env": "staging"},
+					},
+				}
+				if err := r.Client.Create(context.TODO(), namespace); err != nil {
+					t.Fatalf("Test %q failed: unable to create namespace: %v", name, err)
+				}
+			}
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "engine-targets",
+						Namespace: "default",
+					},
+				},
+				AppInfo:   mock.appInfo,
+				Selectors: mock.selectors,
+			}
+			targets, err := r.getTargets(engine)
+			if mock.isErr {
+				if !errors.Is(err, errInvalidSelector) {
+					t.Fatalf("Test %q failed: expected invalid selector error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Equal(t, mock.expectedTargets, targets)
+		})
+	}
+}
+
+func TestGetNodeTarget(t *testing.T) {
+	tests := map[string]struct {
+		node          v1alpha1.Node
+		expectedNames []string
+		isErr         bool
+	}{
+		"Test Positive-1": {
+			node:          v1alpha1.Node{Names: "worker-1,worker-2"},
+			expectedNames: []string{"worker-1", "worker-2"},
+		},
+		"Test Positive-2": {
+			node: v1alpha1.Node{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "chaos"}},
+			},
+			expectedNames: []string{"worker-2"},
+		},
+		"Test Positive-3": {
+			node: v1alpha1.Node{
+				Workload: &v1alpha1.Workload{Kind: v1alpha1.WorkloadDeployment, Namespace: "shop", Names: "frontend"},
+			},
+			expectedNames: []string{"worker-1"},
+		},
+		"Test Positive-4": {
+			node:          v1alpha1.Node{Names: "control-plane", AllowControlPlane: true},
+			expectedNames: []string{"control-plane"},
+		},
+		"Test Negative-1": {
+			node:  v1alpha1.Node{Names: "worker-3"},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			node:  v1alpha1.Node{Names: "control-plane"},
+			isErr: true,
+		},
+		"Test Negative-3": {
+			node: v1alpha1.Node{
+				Workload: &v1alpha1.Workload{Kind: v1alpha1.WorkloadDeployment, Namespace: "shop", Labels: "app=cart"},
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			objects := []client.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-2", Labels: map[string]string{"pool": "chaos"}}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}}},
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "shop", UID: "deployment-uid"},
+				},
+				&appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "frontend-abc",
+						Namespace:       "shop",
+						UID:             "replicaset-uid",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "frontend", UID: "deployment-uid"}},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "frontend-abc-0",
+						Namespace:       "shop",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "frontend-abc", UID: "replicaset-uid"}},
+					},
+					Spec: corev1.PodSpec{NodeName: "worker-1"},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "frontend-abc-1",
+						Namespace:       "shop",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "frontend-abc", UID: "replicaset-uid"}},
+					},
+					Spec: corev1.PodSpec{NodeName: "worker-1"},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "cart-0", Namespace: "shop"},
+					Spec:       corev1.PodSpec{NodeName: "worker-2"},
+				},
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			target, err := r.getNodeTarget(mock.node)
+			if mock.isErr {
+				if !errors.Is(err, errInvalidSelector) {
+					t.Fatalf("Test %q failed: expected invalid selector error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Equal(t, "node", target.Kind)
+			require.ElementsMatch(t, mock.expectedNames, target.Names)
+		})
+	}
+}
+
+func TestGetWorkloadTargetsWithOwnerReferences(t *testing.T) {
+	tests := map[string]struct {
+		workload     v1alpha1.Workload
+		expectedPods []string
+		isErr        bool
+	}{
+		"Test Positive-1": {
+			workload:     v1alpha1.Workload{Kind: v1alpha1.WorkloadCronJob, Namespace: "batch", Names: "nightly-report"},
+			expectedPods: []string{"nightly-report-1-abcde"},
+		},
+		"Test Positive-2": {
+			workload:     v1alpha1.Workload{Kind: v1alpha1.WorkloadJob, Namespace: "batch", Names: "migration"},
+			expectedPods: []string{"migration-fghij"},
+		},
+		"Test Positive-3": {
+			// the labels select the jobs, whose pods are resolved through their owner references
+			workload:     v1alpha1.Workload{Kind: v1alpha1.WorkloadJob, Namespace: "batch", Labels: "app=report"},
+			expectedPods: []string{"nightly-report-1-abcde"},
+		},
+		"Test Positive-4": {
+			// the walk from the pod of the migration job stops at its workflow, which the operator isn't allowed to read
+			workload:     v1alpha1.Workload{Kind: v1alpha1.WorkloadCronJob, Namespace: "batch", Labels: "app=report"},
+			expectedPods: []string{"nightly-report-1-abcde"},
+		},
+		"Test Negative-1": {
+			workload: v1alpha1.Workload{Kind: v1alpha1.WorkloadJob, Namespace: "batch", Names: "backfill"},
+			isErr:    true,
+		},
+		"Test Negative-2": {
+			// the pods owned by a job don't match the replicaset kind, even with matching labels
+			workload: v1alpha1.Workload{Kind: v1alpha1.WorkloadReplicaSet, Namespace: "batch", Labels: "app=report"},
+			isErr:    true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			r.APIReader = forbiddenKindReader{Reader: r.APIReader, kind: "Workflow"}
+			cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", Namespace: "batch", UID: "cronjob-uid", Labels: map[string]string{"app": "report"}}}
+			jobs := []*batchv1.Job{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "nightly-report-1",
+						Namespace:       "batch",
+						UID:             "job-1-uid",
+						Labels:          map[string]string{"app": "report"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: "nightly-report", UID: "cronjob-uid"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "migration",
+						Namespace:       "batch",
+						UID:             "job-2-uid",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow", Name: "migrate", UID: "workflow-uid"}},
+					},
+				},
+			}
+			pods := []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "nightly-report-1-abcde",
+						Namespace:       "batch",
+						Labels:          map[string]string{"app": "report"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: "nightly-report-1", UID: "job-1-uid"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "migration-fghij",
+						Namespace:       "batch",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: "migration", UID: "job-2-uid"}},
+					},
+				},
+			}
+			objects := []client.Object{cronJob}
+			for _, job := range jobs {
+				objects = append(objects, job)
+			}
+			for _, pod := range pods {
+				objects = append(objects, pod)
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			targets, err := r.getWorkloadTargets(mock.workload)
+			if mock.isErr {
+				if !errors.Is(err, errInvalidSelector) {
+					t.Fatalf("Test %q failed: expected invalid selector error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			require.Len(t, targets, 1)
+			require.Equal(t, mock.expectedPods, targets[0].Pods)
+		})
+	}
+}
+
+func TestUpdateTargetsStatus(t *testing.T) {
+	tests := map[string]struct {
+		targets           []chaosTypes.Target
+		expectedWorkloads []v1alpha1.ResolvedTarget
+		expectedPods      []v1alpha1.ResolvedTarget
+		expectedNodes     []v1alpha1.ResolvedTarget
+		expectedCount     int
+	}{
+		"Test Positive-1": {
+			targets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop", Labels: "app=frontend"},
+			},
+			expectedWorkloads: []v1alpha1.ResolvedTarget{
+				{Kind: "Deployment", APIVersion: "apps/v1", Namespace: "shop", Name: "frontend"},
+			},
+			expectedPods: []v1alpha1.ResolvedTarget{
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-1"},
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-2"},
+			},
+			expectedCount: 2,
+		},
+		"Test Positive-2": {
+			targets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop", Names: []string{"frontend"}},
+				{Kind: "pod", Namespace: "shop", Names: []string{"frontend-abc-1", "deleted-pod"}},
+				{Kind: "node", Names: []string{"worker-1"}},
+			},
+			expectedWorkloads: []v1alpha1.ResolvedTarget{
+				{Kind: "Deployment", APIVersion: "apps/v1", Namespace: "shop", Name: "frontend"},
+			},
+			expectedPods: []v1alpha1.ResolvedTarget{
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-1"},
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-2"},
+			},
+			expectedNodes: []v1alpha1.ResolvedTarget{
+				{Kind: "Node", APIVersion: "v1", Name: "worker-1"},
+			},
+			expectedCount: 3,
+		},
+		"Test Positive-3": {
+			// the applabel of the appinfo is a label of the pods, rather than of their workloads
+			targets: []chaosTypes.Target{
+				{Kind: "deployment", Namespace: "shop", Labels: "tier=web", IsAppInfo: true},
+			},
+			expectedWorkloads: []v1alpha1.ResolvedTarget{
+				{Kind: "Deployment", APIVersion: "apps/v1", Namespace: "shop", Name: "frontend"},
+			},
+			expectedPods: []v1alpha1.ResolvedTarget{
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-1"},
+				{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: "frontend-abc-2"},
+			},
+			expectedCount: 2,
+		},
+		"Test Positive-4": {
+			// the pods of the appinfo are only kept if they are owned by a workload of the appkind
+			targets: []chaosTypes.Target{
+				{Kind: "statefulset", Namespace: "shop", Labels: "tier=web", IsAppInfo: true},
+			},
+			expectedCount: 0,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			engine := &v1alpha1.ChaosEngine{ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"}}
+			objects := []client.Object{
+				engine,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "shop", UID: "deployment-uid", Labels: map[string]string{"app": "frontend"}}},
+				&appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "frontend-abc",
+						Namespace:       "shop",
+						UID:             "replicaset-uid",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "frontend", UID: "deployment-uid"}},
+					},
+				},
+			}
+			for _, podName := range []string{"frontend-abc-1", "frontend-abc-2"} {
+				objects = append(objects, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            podName,
+						Namespace:       "shop",
+						Labels:          map[string]string{"app": "frontend", "tier": "web"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "frontend-abc", UID: "replicaset-uid"}},
+					},
+				})
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			engineInfo := &chaosTypes.EngineInfo{Instance: engine}
+			if err := r.updateTargetsStatus(engineInfo, mock.targets); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			updated := &v1alpha1.ChaosEngine{}
+			if err := r.Client.Get(context.TODO(), client.ObjectKeyFromObject(engine), updated); err != nil {
+				t.Fatalf("Test %q failed: unable to get chaosengine: %v", name, err)
+			}
+			require.NotNil(t, updated.Status.Targets)
+			require.Equal(t, mock.expectedWorkloads, updated.Status.Targets.Workloads)
+			require.Equal(t, mock.expectedPods, updated.Status.Targets.Pods)
+			require.Equal(t, mock.expectedNodes, updated.Status.Targets.Nodes)
+			require.Equal(t, mock.expectedCount, updated.Status.Targets.Count)
+			require.False(t, updated.Status.Targets.LastUpdateTime.IsZero())
+		})
+	}
+}
+
+func TestIsTargetsRefreshDue(t *testing.T) {
+	tests := map[string]struct {
+		targets *v1alpha1.TargetsStatus
+		isDue   bool
+	}{
+		"Test Positive-1": {
+			isDue: true,
+		},
+		"Test Positive-2": {
+			targets: &v1alpha1.TargetsStatus{LastUpdateTime: metav1.NewTime(time.Now().Add(-2 * targetsRefreshInterval))},
+			isDue:   true,
+		},
+		"Test Negative-1": {
+			targets: &v1alpha1.TargetsStatus{LastUpdateTime: metav1.Now()},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{Status: v1alpha1.ChaosEngineStatus{Targets: mock.targets}},
+			}
+			require.Equal(t, mock.isDue, isTargetsRefreshDue(engine))
+		})
+	}
+}
+
+func TestCheckBlastRadius(t *testing.T) {
+	pod := func(name string) v1alpha1.ResolvedTarget {
+		return v1alpha1.ResolvedTarget{Kind: "Pod", APIVersion: "v1", Namespace: "shop", Name: name}
+	}
+	tests := map[string]struct {
+		spec    v1alpha1.ChaosEngineSpec
+		targets *v1alpha1.TargetsStatus
+		isErr   bool
+	}{
+		"Test Positive-1": {
+			spec:    v1alpha1.ChaosEngineSpec{MaxTargetCount: 2, MaxTargetPercentage: 50},
+			targets: &v1alpha1.TargetsStatus{Pods: []v1alpha1.ResolvedTarget{pod("shop-1"), pod("shop-2")}, Count: 2},
+		},
+		"Test Positive-2": {
+			spec:    v1alpha1.ChaosEngineSpec{},
+			targets: &v1alpha1.TargetsStatus{Pods: []v1alpha1.ResolvedTarget{pod("shop-1"), pod("shop-2"), pod("shop-3"), pod("shop-4")}, Count: 4},
+		},
+		"Test Negative-1": {
+			spec:    v1alpha1.ChaosEngineSpec{MaxTargetCount: 1},
+			targets: &v1alpha1.TargetsStatus{Pods: []v1alpha1.ResolvedTarget{pod("shop-1"), pod("shop-2")}, Count: 2},
+			isErr:   true,
+		},
+		"Test Negative-2": {
+			spec:    v1alpha1.ChaosEngineSpec{MaxTargetPercentage: 50},
+			targets: &v1alpha1.TargetsStatus{Pods: []v1alpha1.ResolvedTarget{pod("shop-1"), pod("shop-2"), pod("shop-3")}, Count: 3},
+			isErr:   true,
+		},
+		"Test Negative-3": {
+			spec: v1alpha1.ChaosEngineSpec{MaxTargetPercentage: 50},
+			targets: &v1alpha1.TargetsStatus{
+				Nodes: []v1alpha1.ResolvedTarget{{Kind: "Node", APIVersion: "v1", Name: "worker-1"}, {Kind: "Node", APIVersion: "v1", Name: "worker-2"}},
+				Count: 2,
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			objects := []client.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-2"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-3"}},
+			}
+			for _, podName := range []string{"shop-1", "shop-2", "shop-3", "shop-4"} {
+				objects = append(objects, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "shop"}})
+			}
+			for _, obj := range objects {
+				if err := r.Client.Create(context.TODO(), obj); err != nil {
+					t.Fatalf("Test %q failed: unable to create %s: %v", name, obj.GetName(), err)
+				}
+			}
+
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "shop"},
+					Spec:       mock.spec,
+					Status:     v1alpha1.ChaosEngineStatus{Targets: mock.targets},
+				},
+			}
+			err := r.checkBlastRadius(engine)
+			if mock.isErr {
+				if !errors.Is(err, errBlastRadiusExceeded) {
+					t.Fatalf("Test %q failed: expected blast radius error, got %v", name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/tools/reference"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// scheduleLabel is added to every ChaosEngine created by a ChaosSchedule
+	scheduleLabel = "litmuschaos.io/chaos-schedule"
+	// scheduledTimeAnnotation holds the time at which a ChaosEngine was scheduled
+	scheduledTimeAnnotation = "litmuschaos.io/scheduled-at"
+	// defaultScheduleHistoryLimit is the number of finished ChaosEngines retained per schedule
+	defaultScheduleHistoryLimit = 3
+	// maxMissedSchedules is the number of missed runs after which the schedule is considered broken
+	maxMissedSchedules = 100
+)
+
+// ChaosScheduleReconciler reconciles a ChaosSchedule object
+type ChaosScheduleReconciler struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client.Client
+	// Used for serializing and deserializing API objects(group, version, and kind)
+	Scheme *runtime.Scheme
+	// recorder is an event recorder for recording Event resources to the
+	// Kubernetes API.
+	Recorder record.EventRecorder
+	// Now returns the current time, it defaults to time.Now
+	Now func() time.Time
+}
+
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosschedules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosschedules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=litmuschaos.io,resources=chaosschedules/finalizers,verbs=update
+
+// Reconcile creates a new ChaosEngine from the template of the ChaosSchedule whenever a run is due,
+// enforces its concurrency policy and prunes the finished ChaosEngines beyond the history limit
+func (r *ChaosScheduleReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := chaosTypes.ScheduleLog.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling ChaosSchedule")
+
+	schedule := &litmuschaosv1alpha1.ChaosSchedule{}
+	if err := r.Client.Get(ctx, request.NamespacedName, schedule); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	engineList := &litmuschaosv1alpha1.ChaosEngineList{}
+	if err := r.Client.List(ctx, engineList, client.InNamespace(request.Namespace), client.MatchingLabels{scheduleLabel: schedule.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+	activeEngines, finishedEngines := splitScheduledEngines(engineList.Items)
+
+	if err := r.updateScheduleStatus(ctx, schedule, activeEngines, finishedEngines); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.removeExpiredEngines(ctx, schedule, finishedEngines); err != nil {
+		r.Recorder.Eventf(schedule, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "Unable to remove the ChaosEngines beyond the history limit")
+		return reconcile.Result{}, err
+	}
+
+	if schedule.Spec.Suspend {
+		reqLogger.Info("Skip reconcile: ChaosSchedule is suspended")
+		return reconcile.Result{}, nil
+	}
+
+	now := r.now()
+	missedRun, nextRun, err := getNextSchedule(schedule, now)
+	if err != nil {
+		// the schedule can't be fixed by retrying, wait for the next update of the ChaosSchedule
+		r.Recorder.Eventf(schedule, corev1.EventTypeWarning, "InvalidSchedule", "Unable to compute the next run: %v", err)
+		return reconcile.Result{}, nil
+	}
+	result := reconcile.Result{RequeueAfter: nextRun.Sub(now)}
+
+	if missedRun.IsZero() {
+		return result, nil
+	}
+
+	switch schedule.Spec.ConcurrencyPolicy {
+	case litmuschaosv1alpha1.ConcurrencyPolicyAllow:
+	case litmuschaosv1alpha1.ConcurrencyPolicyReplace:
+		for i := range activeEngines {
+			if err := r.Client.Delete(ctx, &activeEngines[i], client.PropagationPolicy(v1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+				r.Recorder.Eventf(schedule, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "Unable to replace the running ChaosEngine %s", activeEngines[i].Name)
+				return reconcile.Result{}, err
+			}
+		}
+	default:
+		if len(activeEngines) != 0 {
+			reqLogger.Info("Skip run: previous ChaosEngine is still running", "chaosengine", activeEngines[0].Name)
+			r.Recorder.Eventf(schedule, corev1.EventTypeNormal, "ChaosScheduleSkipped", "Skipped the run at %s as ChaosEngine %s is still running", missedRun.Format(time.RFC3339), activeEngines[0].Name)
+			return result, nil
+		}
+	}
+
+	engine, err := newChaosEngineForSchedule(schedule, missedRun, r.Scheme)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.Client.Create(ctx, engine); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return result, nil
+		}
+		r.Recorder.Eventf(schedule, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "Unable to create ChaosEngine %s", engine.Name)
+		return reconcile.Result{}, err
+	}
+	reqLogger.Info("Created ChaosEngine for scheduled run", "chaosengine", engine.Name, "scheduledTime", missedRun)
+	r.Recorder.Eventf(schedule, corev1.EventTypeNormal, "ChaosEngineCreated", "Created ChaosEngine %s", engine.Name)
+
+	schedule.Status.LastScheduleTime = &v1.Time{Time: missedRun}
+	if err := r.Client.Status().Update(ctx, schedule); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to update ChaosSchedule status, due to error: %v", err)
+	}
+
+	return result, nil
+}
+
+// now returns the current time of the reconciler
+func (r *ChaosScheduleReconciler) now() time.Time {
+	if r.Now == nil {
+		return time.Now()
+	}
+	return r.Now()
+}
+
+// updateScheduleStatus refreshes the active engines and the last schedule time of the ChaosSchedule
+func (r *ChaosScheduleReconciler) updateScheduleStatus(ctx context.Context, schedule *litmuschaosv1alpha1.ChaosSchedule, activeEngines, finishedEngines []litmuschaosv1alpha1.ChaosEngine) error {
+	schedule.Status.Active = nil
+	for i := range activeEngines {
+		ref, err := reference.GetReference(r.Scheme, &activeEngines[i])
+		if err != nil {
+			return err
+		}
+		schedule.Status.Active = append(schedule.Status.Active, *ref)
+	}
+
+	// the annotations of the created engines are the source of truth, in case the last status update was lost
+	engines := make([]litmuschaosv1alpha1.ChaosEngine, 0, len(activeEngines)+len(finishedEngines))
+	engines = append(append(engines, activeEngines...), finishedEngines...)
+	for _, engine := range engines {
+		scheduledTime, err := getScheduledTime(engine)
+		if err != nil || scheduledTime == nil {
+			continue
+		}
+		if schedule.Status.LastScheduleTime == nil || schedule.Status.LastScheduleTime.Before(scheduledTime) {
+			schedule.Status.LastScheduleTime = scheduledTime
+		}
+	}
+
+	if err := r.Client.Status().Update(ctx, schedule); err != nil {
+		return fmt.Errorf("unable to update ChaosSchedule status, due to error: %v", err)
+	}
+	return nil
+}
+
+// removeExpiredEngines deletes the oldest finished ChaosEngines beyond the history limit
+func (r *ChaosScheduleReconciler) removeExpiredEngines(ctx context.Context, schedule *litmuschaosv1alpha1.ChaosSchedule, finishedEngines []litmuschaosv1alpha1.ChaosEngine) error {
+	historyLimit := defaultScheduleHistoryLimit
+	if schedule.Spec.HistoryLimit != nil {
+		historyLimit = int(*schedule.Spec.HistoryLimit)
+	}
+	if len(finishedEngines) <= historyLimit {
+		return nil
+	}
+
+	sort.Slice(finishedEngines, func(i, j int) bool {
+		return finishedEngines[i].CreationTimestamp.Before(&finishedEngines[j].CreationTimestamp)
+	})
+	for i := 0; i < len(finishedEngines)-historyLimit; i++ {
+		if err := r.Client.Delete(ctx, &finishedEngines[i], client.PropagationPolicy(v1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitScheduledEngines splits the ChaosEngines of a schedule into the active and finished ones
+func splitScheduledEngines(engines []litmuschaosv1alpha1.ChaosEngine) (activeEngines, finishedEngines []litmuschaosv1alpha1.ChaosEngine) {
+	for _, engine := range engines {
+		if isEngineFinished(engine) {
+			finishedEngines = append(finishedEngines, engine)
+			continue
+		}
+		activeEngines = append(activeEngines, engine)
+	}
+	return activeEngines, finishedEngines
+}
+
+// isEngineFinished checks whether the ChaosEngine has run to completion or has been stopped
+func isEngineFinished(engine litmuschaosv1alpha1.ChaosEngine) bool {
+	switch engine.Status.EngineStatus {
+	case litmuschaosv1alpha1.EngineStatusCompleted, litmuschaosv1alpha1.EngineStatusStopped, litmuschaosv1alpha1.EngineStatusError:
+		return true
+	}
+	return false
+}
+
+// getScheduledTime returns the scheduled time recorded on a ChaosEngine created by a ChaosSchedule
+func getScheduledTime(engine litmuschaosv1alpha1.ChaosEngine) (*v1.Time, error) {
+	value, ok := engine.Annotations[scheduledTimeAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	scheduledTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.Time{Time: scheduledTime}, nil
+}
+
+// getNextSchedule returns the latest run which is due but hasn't been started yet (zero if there is none)
+// along with the time of the next run
+func getNextSchedule(schedule *litmuschaosv1alpha1.ChaosSchedule, now time.Time) (time.Time, time.Time, error) {
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unparseable schedule %q: %v", schedule.Spec.Schedule, err)
+	}
+
+	location := time.Local
+	if schedule.Spec.TimeZone != "" {
+		if location, err = time.LoadLocation(schedule.Spec.TimeZone); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("unknown time zone %q: %v", schedule.Spec.TimeZone, err)
+		}
+	}
+	now = now.In(location)
+
+	earliestTime := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		earliestTime = schedule.Status.LastScheduleTime.Time
+	}
+	if schedule.Spec.StartingDeadlineSeconds != nil {
+		// runs which missed their starting deadline are not started anymore
+		schedulingDeadline := now.Add(-time.Second * time.Duration(*schedule.Spec.StartingDeadlineSeconds))
+		if schedulingDeadline.After(earliestTime) {
+			earliestTime = schedulingDeadline
+		}
+	}
+	if earliestTime.After(now) {
+		return time.Time{}, cronSchedule.Next(now), nil
+	}
+
+	var lastMissed time.Time
+	missed := 0
+	for t := cronSchedule.Next(earliestTime.In(location)); !t.After(now); t = cronSchedule.Next(t) {
+		lastMissed = t
+		missed++
+		if missed > maxMissedSchedules {
+			return time.Time{}, time.Time{}, fmt.Errorf("too many missed start times (> %d), set or decrease .spec.startingDeadlineSeconds or check clock skew", maxMissedSchedules)
+		}
+	}
+
+	return lastMissed, cronSchedule.Next(now), nil
+}
+
+// newChaosEngineForSchedule creates the ChaosEngine of a scheduled run from the template of the ChaosSchedule
+func newChaosEngineForSchedule(schedule *litmuschaosv1alpha1.ChaosSchedule, scheduledTime time.Time, scheme *runtime.Scheme) (*litmuschaosv1alpha1.ChaosEngine, error) {
+	template := schedule.Spec.EngineTemplate.DeepCopy()
+
+	engine := &litmuschaosv1alpha1.ChaosEngine{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", schedule.Name, scheduledTime.Unix()),
+			Namespace:   schedule.Namespace,
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	if engine.Labels == nil {
+		engine.Labels = map[string]string{}
+	}
+	if engine.Annotations == nil {
+		engine.Annotations = map[string]string{}
+	}
+	engine.Labels[scheduleLabel] = schedule.Name
+	engine.Annotations[scheduledTimeAnnotation] = scheduledTime.Format(time.RFC3339)
+	engine.Spec.EngineState = litmuschaosv1alpha1.EngineStateActive
+
+	if err := controllerutil.SetControllerReference(schedule, engine, scheme); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ChaosScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litmuschaosv1alpha1.ChaosSchedule{}).
+		Owns(&litmuschaosv1alpha1.ChaosEngine{}).
+		Complete(r)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	litmusFakeClientset "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestGetNextSchedule(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deadline := int64(300)
+
+	tests := map[string]struct {
+		schedule       *v1alpha1.ChaosSchedule
+		now            time.Time
+		expectedMissed time.Time
+		expectedNext   time.Time
+		isErr          bool
+	}{
+		"Test Positive-1": {
+			schedule: &v1alpha1.ChaosSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+				Spec:       v1alpha1.ChaosScheduleSpec{Schedule: "0 2 * * *", TimeZone: "UTC"},
+			},
+			now:          created.Add(time.Hour),
+			expectedNext: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC),
+		},
+		"Test Positive-2": {
+			schedule: &v1alpha1.ChaosSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+				Spec:       v1alpha1.ChaosScheduleSpec{Schedule: "0 2 * * *", TimeZone: "UTC"},
+			},
+			now:            created.Add(50 * time.Hour),
+			expectedMissed: time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+			expectedNext:   time.Date(2024, 1, 4, 2, 0, 0, 0, time.UTC),
+		},
+		"Test Positive-3": {
+			schedule: &v1alpha1.ChaosSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+				Spec:       v1alpha1.ChaosScheduleSpec{Schedule: "0 2 * * *", TimeZone: "Asia/Kolkata"},
+			},
+			now:            created.Add(24 * time.Hour),
+			expectedMissed: time.Date(2024, 1, 1, 20, 30, 0, 0, time.UTC),
+			expectedNext:   time.Date(2024, 1, 2, 20, 30, 0, 0, time.UTC),
+		},
+		"Test Positive-4": {
+			schedule: &v1alpha1.ChaosSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+				Spec:       v1alpha1.ChaosScheduleSpec{Schedule: "0 2 * * *", TimeZone: "UTC", StartingDeadlineSeconds: &deadline},
+			},
+			now:          time.Date(2024, 1, 3, 3, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2024, 1, 4, 2, 0, 0, 0, time.UTC),
+		},
+		"Test Negative-1": {
+			schedule: &v1alpha1.ChaosSchedule{
+				Spec: v1alpha1.ChaosScheduleSpec{Schedule: "every night"},
+			},
+			now:   created,
+			isErr: true,
+		},
+		"Test Negative-2": {
+			schedule: &v1alpha1.ChaosSchedule{
+				Spec: v1alpha1.ChaosScheduleSpec{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus_Mons"},
+			},
+			now:   created,
+			isErr: true,
+		},
+		"Test Negative-3": {
+			schedule: &v1alpha1.ChaosSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+				Spec:       v1alpha1.ChaosScheduleSpec{Schedule: "* * * * *", TimeZone: "UTC"},
+			},
+			now:   created.Add(3 * time.Hour),
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			missed, next, err := getNextSchedule(mock.schedule, mock.now)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if mock.isErr {
+				return
+			}
+			if !missed.Equal(mock.expectedMissed) {
+				t.Fatalf("Test %q failed: expected missed run %v, received %v", name, mock.expectedMissed, missed)
+			}
+			if !next.Equal(mock.expectedNext) {
+				t.Fatalf("Test %q failed: expected next run %v, received %v", name, mock.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestReconcileChaosSchedule(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 2, 0, 30, 0, time.UTC)
+	historyLimit := int32(1)
+
+	newSchedule := func(name string, policy v1alpha1.ConcurrencyPolicy, suspend bool) *v1alpha1.ChaosSchedule {
+		return &v1alpha1.ChaosSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Spec: v1alpha1.ChaosScheduleSpec{
+				Schedule:          "0 2 * * *",
+				TimeZone:          "UTC",
+				ConcurrencyPolicy: policy,
+				Suspend:           suspend,
+				HistoryLimit:      &historyLimit,
+				EngineTemplate: v1alpha1.ChaosEngineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"team": "sre"},
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Experiments: []v1alpha1.ExperimentList{
+							{
+								Name: "pod-delete",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newEngine := func(name, schedule string, status v1alpha1.EngineStatus) *v1alpha1.ChaosEngine {
+		return &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{scheduleLabel: schedule},
+			},
+			Status: v1alpha1.ChaosEngineStatus{
+				EngineStatus: status,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		schedule        *v1alpha1.ChaosSchedule
+		engines         []*v1alpha1.ChaosEngine
+		expectedEngines int
+		expectedCreated bool
+	}{
+		"Test Positive-1": {
+			schedule:        newSchedule("nightly", v1alpha1.ConcurrencyPolicyForbid, false),
+			expectedEngines: 1,
+			expectedCreated: true,
+		},
+		"Test Positive-2": {
+			schedule: newSchedule("nightly", v1alpha1.ConcurrencyPolicyForbid, false),
+			engines: []*v1alpha1.ChaosEngine{
+				newEngine("nightly-running", "nightly", v1alpha1.EngineStatusInitialized),
+			},
+			expectedEngines: 1,
+			expectedCreated: false,
+		},
+		"Test Positive-3": {
+			schedule: newSchedule("nightly", v1alpha1.ConcurrencyPolicyAllow, false),
+			engines: []*v1alpha1.ChaosEngine{
+				newEngine("nightly-running", "nightly", v1alpha1.EngineStatusInitialized),
+			},
+			expectedEngines: 2,
+			expectedCreated: true,
+		},
+		"Test Positive-4": {
+			schedule: newSchedule("nightly", v1alpha1.ConcurrencyPolicyForbid, false),
+			engines: []*v1alpha1.ChaosEngine{
+				newEngine("nightly-old", "nightly", v1alpha1.EngineStatusCompleted),
+				newEngine("nightly-older", "nightly", v1alpha1.EngineStatusStopped),
+			},
+			expectedEngines: 2,
+			expectedCreated: true,
+		},
+		"Test Positive-5": {
+			schedule:        newSchedule("nightly", v1alpha1.ConcurrencyPolicyForbid, true),
+			expectedEngines: 0,
+			expectedCreated: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeScheduleClient(t, now)
+			if err := r.Client.Create(context.TODO(), mock.schedule); err != nil {
+				t.Fatalf("Test %q failed: unable to create schedule: %v", name, err)
+			}
+			for _, engine := range mock.engines {
+				if err := r.Client.Create(context.TODO(), engine); err != nil {
+					t.Fatalf("Test %q failed: unable to create engine: %v", name, err)
+				}
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: mock.schedule.Name, Namespace: mock.schedule.Namespace}}
+			if _, err := r.Reconcile(context.TODO(), request); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+
+			engineList := &v1alpha1.ChaosEngineList{}
+			if err := r.Client.List(context.TODO(), engineList, client.InNamespace("default")); err != nil {
+				t.Fatalf("Test %q failed: unable to list engines: %v", name, err)
+			}
+			if len(engineList.Items) != mock.expectedEngines {
+				t.Fatalf("Test %q failed: expected %d engines, received %d", name, mock.expectedEngines, len(engineList.Items))
+			}
+
+			created := &v1alpha1.ChaosEngine{}
+			err := r.Client.Get(context.TODO(), types.NamespacedName{Name: "nightly-1704074400", Namespace: "default"}, created)
+			if mock.expectedCreated != (err == nil) {
+				t.Fatalf("Test %q failed: expected scheduled engine creation to be %v, got error %v", name, mock.expectedCreated, err)
+			}
+			if mock.expectedCreated {
+				if created.Labels[scheduleLabel] != "nightly" || created.Labels["team"] != "sre" {
+					t.Fatalf("Test %q failed: unexpected labels %v on the scheduled engine", name, created.Labels)
+				}
+				if created.Spec.EngineState != v1alpha1.EngineStateActive {
+					t.Fatalf("Test %q failed: expected engineState to be active", name)
+				}
+			}
+		})
+	}
+}
+
+func CreateFakeScheduleClient(t *testing.T, now time.Time) *ChaosScheduleReconciler {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+
+	return &ChaosScheduleReconciler{
+		Client:   litmusFakeClientset.NewClientBuilder().WithScheme(s).Build(),
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(1024),
+		Now:      func() time.Time { return now },
+	}
+}
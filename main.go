@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/litmuschaos/chaos-operator/pkg/analytics"
@@ -110,14 +111,54 @@ func main() {
 		os.Exit(1)
 	}
 
+	// the concurrency limits of the chaosengines, unlimited if not set
+	maxActiveEngines, err := getEnvInt("MAX_ACTIVE_ENGINES")
+	if err != nil {
+		setupLog.Error(err, "failed to get the concurrency limit of the chaosengines")
+		os.Exit(1)
+	}
+	maxActiveEnginesPerNamespace, err := getEnvInt("MAX_ACTIVE_ENGINES_PER_NAMESPACE")
+	if err != nil {
+		setupLog.Error(err, "failed to get the concurrency limit of the chaosengines")
+		os.Exit(1)
+	}
+
+	// the runner image of the chaosengines which don't set it
+	runnerImage := os.Getenv("CHAOS_RUNNER_IMAGE")
+
 	if err = (&controllers.ChaosEngineReconciler{
+		Client:                       mgr.GetClient(),
+		APIReader:                    mgr.GetAPIReader(),
+		Scheme:                       mgr.GetScheme(),
+		Recorder:                     mgr.GetEventRecorderFor("chaos-operator"),
+		OperatorNamespace:            os.Getenv("POD_NAMESPACE"),
+		MaxActiveEngines:             maxActiveEngines,
+		MaxActiveEnginesPerNamespace: maxActiveEnginesPerNamespace,
+		RequireNamespaceOptIn:        strings.ToUpper(os.Getenv("REQUIRE_NAMESPACE_OPT_IN")) == "TRUE",
+		RunnerImage:                  runnerImage,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosEngine")
+		os.Exit(1)
+	}
+	if err = (&controllers.ChaosScheduleReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("chaos-operator"),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ChaosEngine")
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosSchedule")
 		os.Exit(1)
 	}
+	// The admission webhooks need a serving certificate, hence they are served only when enabled
+	if enableWebhooks := strings.ToUpper(os.Getenv("ENABLE_WEBHOOKS")); enableWebhooks == "TRUE" {
+		if err = (&litmuschaosiov1alpha1.ChaosEngine{}).SetupWebhookWithManager(mgr, runnerImage); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ChaosEngine")
+			os.Exit(1)
+		}
+		if err = (&litmuschaosiov1alpha1.ChaosExperiment{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ChaosExperiment")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -141,3 +182,16 @@ func printVersion() {
 	setupLog.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	setupLog.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
 }
+
+// getEnvInt returns the non-negative integer value of the env, or 0 if it is not set
+func getEnvInt(name string) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+	result, err := strconv.Atoi(value)
+	if err != nil || result < 0 {
+		return 0, fmt.Errorf("invalid %s env %q, expected a non-negative integer", name, value)
+	}
+	return result, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	scheme "github.com/litmuschaos/chaos-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ChaosBlackoutsGetter has a method to return a ChaosBlackoutInterface.
+// A group's client should implement this interface.
+type ChaosBlackoutsGetter interface {
+	ChaosBlackouts() ChaosBlackoutInterface
+}
+
+// ChaosBlackoutInterface has methods to work with ChaosBlackout resources.
+type ChaosBlackoutInterface interface {
+	Create(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.CreateOptions) (*v1alpha1.ChaosBlackout, error)
+	Update(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.UpdateOptions) (*v1alpha1.ChaosBlackout, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ChaosBlackout, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ChaosBlackoutList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ChaosBlackout, err error)
+	ChaosBlackoutExpansion
+}
+
+// chaosBlackouts implements ChaosBlackoutInterface
+type chaosBlackouts struct {
+	client rest.Interface
+}
+
+// newChaosBlackouts returns a ChaosBlackouts
+func newChaosBlackouts(c *LitmuschaosV1alpha1Client) *chaosBlackouts {
+	return &chaosBlackouts{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the chaosBlackout, and returns the corresponding chaosBlackout object, and an error if there is any.
+func (c *chaosBlackouts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	result = &v1alpha1.ChaosBlackout{}
+	err = c.client.Get().
+		Resource("chaosblackouts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ChaosBlackouts that match those selectors.
+func (c *chaosBlackouts) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ChaosBlackoutList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ChaosBlackoutList{}
+	err = c.client.Get().
+		Resource("chaosblackouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested chaosBlackouts.
+func (c *chaosBlackouts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("chaosblackouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a chaosBlackout and creates it.  Returns the server's representation of the chaosBlackout, and an error, if there is any.
+func (c *chaosBlackouts) Create(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.CreateOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	result = &v1alpha1.ChaosBlackout{}
+	err = c.client.Post().
+		Resource("chaosblackouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaosBlackout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a chaosBlackout and updates it. Returns the server's representation of the chaosBlackout, and an error, if there is any.
+func (c *chaosBlackouts) Update(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.UpdateOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	result = &v1alpha1.ChaosBlackout{}
+	err = c.client.Put().
+		Resource("chaosblackouts").
+		Name(chaosBlackout.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaosBlackout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the chaosBlackout and deletes it. Returns an error if one occurs.
+func (c *chaosBlackouts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("chaosblackouts").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *chaosBlackouts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("chaosblackouts").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched chaosBlackout.
+func (c *chaosBlackouts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ChaosBlackout, err error) {
+	result = &v1alpha1.ChaosBlackout{}
+	err = c.client.Patch(pt).
+		Resource("chaosblackouts").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
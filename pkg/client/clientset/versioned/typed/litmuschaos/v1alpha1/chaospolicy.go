@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	scheme "github.com/litmuschaos/chaos-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ChaosPoliciesGetter has a method to return a ChaosPolicyInterface.
+// A group's client should implement this interface.
+type ChaosPoliciesGetter interface {
+	ChaosPolicies() ChaosPolicyInterface
+}
+
+// ChaosPolicyInterface has methods to work with ChaosPolicy resources.
+type ChaosPolicyInterface interface {
+	Create(ctx context.Context, chaosPolicy *v1alpha1.ChaosPolicy, opts v1.CreateOptions) (*v1alpha1.ChaosPolicy, error)
+	Update(ctx context.Context, chaosPolicy *v1alpha1.ChaosPolicy, opts v1.UpdateOptions) (*v1alpha1.ChaosPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ChaosPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ChaosPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ChaosPolicy, err error)
+	ChaosPolicyExpansion
+}
+
+// chaosPolicies implements ChaosPolicyInterface
+type chaosPolicies struct {
+	client rest.Interface
+}
+
+// newChaosPolicies returns a ChaosPolicies
+func newChaosPolicies(c *LitmuschaosV1alpha1Client) *chaosPolicies {
+	return &chaosPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the chaosPolicy, and returns the corresponding chaosPolicy object, and an error if there is any.
+func (c *chaosPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ChaosPolicy, err error) {
+	result = &v1alpha1.ChaosPolicy{}
+	err = c.client.Get().
+		Resource("chaospolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ChaosPolicies that match those selectors.
+func (c *chaosPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ChaosPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ChaosPolicyList{}
+	err = c.client.Get().
+		Resource("chaospolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested chaosPolicies.
+func (c *chaosPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("chaospolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a chaosPolicy and creates it.  Returns the server's representation of the chaosPolicy, and an error, if there is any.
+func (c *chaosPolicies) Create(ctx context.Context, chaosPolicy *v1alpha1.ChaosPolicy, opts v1.CreateOptions) (result *v1alpha1.ChaosPolicy, err error) {
+	result = &v1alpha1.ChaosPolicy{}
+	err = c.client.Post().
+		Resource("chaospolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaosPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a chaosPolicy and updates it. Returns the server's representation of the chaosPolicy, and an error, if there is any.
+func (c *chaosPolicies) Update(ctx context.Context, chaosPolicy *v1alpha1.ChaosPolicy, opts v1.UpdateOptions) (result *v1alpha1.ChaosPolicy, err error) {
+	result = &v1alpha1.ChaosPolicy{}
+	err = c.client.Put().
+		Resource("chaospolicies").
+		Name(chaosPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaosPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the chaosPolicy and deletes it. Returns an error if one occurs.
+func (c *chaosPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("chaospolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *chaosPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("chaospolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched chaosPolicy.
+func (c *chaosPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ChaosPolicy, err error) {
+	result = &v1alpha1.ChaosPolicy{}
+	err = c.client.Patch(pt).
+		Resource("chaospolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,122 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeChaosBlackouts implements ChaosBlackoutInterface
+type FakeChaosBlackouts struct {
+	Fake *FakeLitmuschaosV1alpha1
+}
+
+var chaosblackoutsResource = schema.GroupVersionResource{Group: "litmuschaos.io", Version: "v1alpha1", Resource: "chaosblackouts"}
+
+var chaosblackoutsKind = schema.GroupVersionKind{Group: "litmuschaos.io", Version: "v1alpha1", Kind: "ChaosBlackout"}
+
+// Get takes name of the chaosBlackout, and returns the corresponding chaosBlackout object, and an error if there is any.
+func (c *FakeChaosBlackouts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(chaosblackoutsResource, name), &v1alpha1.ChaosBlackout{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ChaosBlackout), err
+}
+
+// List takes label and field selectors, and returns the list of ChaosBlackouts that match those selectors.
+func (c *FakeChaosBlackouts) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ChaosBlackoutList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(chaosblackoutsResource, chaosblackoutsKind, opts), &v1alpha1.ChaosBlackoutList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ChaosBlackoutList{ListMeta: obj.(*v1alpha1.ChaosBlackoutList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ChaosBlackoutList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested chaosBlackouts.
+func (c *FakeChaosBlackouts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(chaosblackoutsResource, opts))
+}
+
+// Create takes the representation of a chaosBlackout and creates it.  Returns the server's representation of the chaosBlackout, and an error, if there is any.
+func (c *FakeChaosBlackouts) Create(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.CreateOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(chaosblackoutsResource, chaosBlackout), &v1alpha1.ChaosBlackout{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ChaosBlackout), err
+}
+
+// Update takes the representation of a chaosBlackout and updates it. Returns the server's representation of the chaosBlackout, and an error, if there is any.
+func (c *FakeChaosBlackouts) Update(ctx context.Context, chaosBlackout *v1alpha1.ChaosBlackout, opts v1.UpdateOptions) (result *v1alpha1.ChaosBlackout, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(chaosblackoutsResource, chaosBlackout), &v1alpha1.ChaosBlackout{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ChaosBlackout), err
+}
+
+// Delete takes name of the chaosBlackout and deletes it. Returns an error if one occurs.
+func (c *FakeChaosBlackouts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(chaosblackoutsResource, name), &v1alpha1.ChaosBlackout{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeChaosBlackouts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(chaosblackoutsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ChaosBlackoutList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched chaosBlackout.
+func (c *FakeChaosBlackouts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ChaosBlackout, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(chaosblackoutsResource, name, pt, data, subresources...), &v1alpha1.ChaosBlackout{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ChaosBlackout), err
+}
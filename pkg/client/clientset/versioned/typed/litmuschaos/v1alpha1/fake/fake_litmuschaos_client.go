@@ -28,6 +28,10 @@ type FakeLitmuschaosV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeLitmuschaosV1alpha1) ChaosBlackouts() v1alpha1.ChaosBlackoutInterface {
+	return &FakeChaosBlackouts{c}
+}
+
 func (c *FakeLitmuschaosV1alpha1) ChaosEngines(namespace string) v1alpha1.ChaosEngineInterface {
 	return &FakeChaosEngines{c, namespace}
 }
@@ -36,10 +40,18 @@ func (c *FakeLitmuschaosV1alpha1) ChaosExperiments(namespace string) v1alpha1.Ch
 	return &FakeChaosExperiments{c, namespace}
 }
 
+func (c *FakeLitmuschaosV1alpha1) ChaosPolicies() v1alpha1.ChaosPolicyInterface {
+	return &FakeChaosPolicies{c}
+}
+
 func (c *FakeLitmuschaosV1alpha1) ChaosResults(namespace string) v1alpha1.ChaosResultInterface {
 	return &FakeChaosResults{c, namespace}
 }
 
+func (c *FakeLitmuschaosV1alpha1) ChaosSchedules(namespace string) v1alpha1.ChaosScheduleInterface {
+	return &FakeChaosSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeLitmuschaosV1alpha1) RESTClient() rest.Interface {
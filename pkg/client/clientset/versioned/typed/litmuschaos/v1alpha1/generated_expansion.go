@@ -18,8 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+type ChaosBlackoutExpansion interface{}
+
 type ChaosEngineExpansion interface{}
 
 type ChaosExperimentExpansion interface{}
 
+type ChaosPolicyExpansion interface{}
+
 type ChaosResultExpansion interface{}
+
+type ChaosScheduleExpansion interface{}
@@ -26,9 +26,12 @@ import (
 
 type LitmuschaosV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ChaosBlackoutsGetter
 	ChaosEnginesGetter
 	ChaosExperimentsGetter
+	ChaosPoliciesGetter
 	ChaosResultsGetter
+	ChaosSchedulesGetter
 }
 
 // LitmuschaosV1alpha1Client is used to interact with features provided by the litmuschaos group.
@@ -36,6 +39,10 @@ type LitmuschaosV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *LitmuschaosV1alpha1Client) ChaosBlackouts() ChaosBlackoutInterface {
+	return newChaosBlackouts(c)
+}
+
 func (c *LitmuschaosV1alpha1Client) ChaosEngines(namespace string) ChaosEngineInterface {
 	return newChaosEngines(c, namespace)
 }
@@ -44,10 +51,18 @@ func (c *LitmuschaosV1alpha1Client) ChaosExperiments(namespace string) ChaosExpe
 	return newChaosExperiments(c, namespace)
 }
 
+func (c *LitmuschaosV1alpha1Client) ChaosPolicies() ChaosPolicyInterface {
+	return newChaosPolicies(c)
+}
+
 func (c *LitmuschaosV1alpha1Client) ChaosResults(namespace string) ChaosResultInterface {
 	return newChaosResults(c, namespace)
 }
 
+func (c *LitmuschaosV1alpha1Client) ChaosSchedules(namespace string) ChaosScheduleInterface {
+	return newChaosSchedules(c, namespace)
+}
+
 // NewForConfig creates a new LitmuschaosV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*LitmuschaosV1alpha1Client, error) {
 	config := *c
@@ -53,12 +53,18 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=litmuschaos, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("chaosblackouts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosBlackouts().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("chaosengines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosEngines().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("chaosexperiments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosExperiments().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("chaospolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("chaosresults"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosResults().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("chaosschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Litmuschaos().V1alpha1().ChaosSchedules().Informer()}, nil
 
 	}
 
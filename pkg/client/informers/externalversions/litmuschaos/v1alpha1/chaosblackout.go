@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	versioned "github.com/litmuschaos/chaos-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/litmuschaos/chaos-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/litmuschaos/chaos-operator/pkg/client/listers/litmuschaos/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ChaosBlackoutInformer provides access to a shared informer and lister for
+// ChaosBlackouts.
+type ChaosBlackoutInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ChaosBlackoutLister
+}
+
+type chaosBlackoutInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewChaosBlackoutInformer constructs a new informer for ChaosBlackout type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewChaosBlackoutInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredChaosBlackoutInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredChaosBlackoutInformer constructs a new informer for ChaosBlackout type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredChaosBlackoutInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.LitmuschaosV1alpha1().ChaosBlackouts().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.LitmuschaosV1alpha1().ChaosBlackouts().Watch(context.TODO(), options)
+			},
+		},
+		&litmuschaosv1alpha1.ChaosBlackout{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *chaosBlackoutInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredChaosBlackoutInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *chaosBlackoutInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&litmuschaosv1alpha1.ChaosBlackout{}, f.defaultInformer)
+}
+
+func (f *chaosBlackoutInformer) Lister() v1alpha1.ChaosBlackoutLister {
+	return v1alpha1.NewChaosBlackoutLister(f.Informer().GetIndexer())
+}
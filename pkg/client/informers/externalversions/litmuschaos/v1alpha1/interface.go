@@ -24,12 +24,18 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ChaosBlackouts returns a ChaosBlackoutInformer.
+	ChaosBlackouts() ChaosBlackoutInformer
 	// ChaosEngines returns a ChaosEngineInformer.
 	ChaosEngines() ChaosEngineInformer
 	// ChaosExperiments returns a ChaosExperimentInformer.
 	ChaosExperiments() ChaosExperimentInformer
+	// ChaosPolicies returns a ChaosPolicyInformer.
+	ChaosPolicies() ChaosPolicyInformer
 	// ChaosResults returns a ChaosResultInformer.
 	ChaosResults() ChaosResultInformer
+	// ChaosSchedules returns a ChaosScheduleInformer.
+	ChaosSchedules() ChaosScheduleInformer
 }
 
 type version struct {
@@ -43,6 +49,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ChaosBlackouts returns a ChaosBlackoutInformer.
+func (v *version) ChaosBlackouts() ChaosBlackoutInformer {
+	return &chaosBlackoutInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ChaosEngines returns a ChaosEngineInformer.
 func (v *version) ChaosEngines() ChaosEngineInformer {
 	return &chaosEngineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -53,7 +64,17 @@ func (v *version) ChaosExperiments() ChaosExperimentInformer {
 	return &chaosExperimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ChaosPolicies returns a ChaosPolicyInformer.
+func (v *version) ChaosPolicies() ChaosPolicyInformer {
+	return &chaosPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ChaosResults returns a ChaosResultInformer.
 func (v *version) ChaosResults() ChaosResultInformer {
 	return &chaosResultInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ChaosSchedules returns a ChaosScheduleInformer.
+func (v *version) ChaosSchedules() ChaosScheduleInformer {
+	return &chaosScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
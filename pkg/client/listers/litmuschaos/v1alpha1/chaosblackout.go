@@ -0,0 +1,68 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ChaosBlackoutLister helps list ChaosBlackouts.
+// All objects returned here must be treated as read-only.
+type ChaosBlackoutLister interface {
+	// List lists all ChaosBlackouts in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ChaosBlackout, err error)
+	// Get retrieves the ChaosBlackout from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ChaosBlackout, error)
+	ChaosBlackoutListerExpansion
+}
+
+// chaosBlackoutLister implements the ChaosBlackoutLister interface.
+type chaosBlackoutLister struct {
+	indexer cache.Indexer
+}
+
+// NewChaosBlackoutLister returns a new ChaosBlackoutLister.
+func NewChaosBlackoutLister(indexer cache.Indexer) ChaosBlackoutLister {
+	return &chaosBlackoutLister{indexer: indexer}
+}
+
+// List lists all ChaosBlackouts in the indexer.
+func (s *chaosBlackoutLister) List(selector labels.Selector) (ret []*v1alpha1.ChaosBlackout, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ChaosBlackout))
+	})
+	return ret, err
+}
+
+// Get retrieves the ChaosBlackout from the index for a given name.
+func (s *chaosBlackoutLister) Get(name string) (*v1alpha1.ChaosBlackout, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("chaosblackout"), name)
+	}
+	return obj.(*v1alpha1.ChaosBlackout), nil
+}
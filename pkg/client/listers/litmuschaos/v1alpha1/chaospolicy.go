@@ -0,0 +1,68 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ChaosPolicyLister helps list ChaosPolicies.
+// All objects returned here must be treated as read-only.
+type ChaosPolicyLister interface {
+	// List lists all ChaosPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ChaosPolicy, err error)
+	// Get retrieves the ChaosPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ChaosPolicy, error)
+	ChaosPolicyListerExpansion
+}
+
+// chaosPolicyLister implements the ChaosPolicyLister interface.
+type chaosPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewChaosPolicyLister returns a new ChaosPolicyLister.
+func NewChaosPolicyLister(indexer cache.Indexer) ChaosPolicyLister {
+	return &chaosPolicyLister{indexer: indexer}
+}
+
+// List lists all ChaosPolicies in the indexer.
+func (s *chaosPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.ChaosPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ChaosPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the ChaosPolicy from the index for a given name.
+func (s *chaosPolicyLister) Get(name string) (*v1alpha1.ChaosPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("chaospolicy"), name)
+	}
+	return obj.(*v1alpha1.ChaosPolicy), nil
+}
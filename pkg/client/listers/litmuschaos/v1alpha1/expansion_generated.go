@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// ChaosBlackoutListerExpansion allows custom methods to be added to
+// ChaosBlackoutLister.
+type ChaosBlackoutListerExpansion interface{}
+
 // ChaosEngineListerExpansion allows custom methods to be added to
 // ChaosEngineLister.
 type ChaosEngineListerExpansion interface{}
@@ -34,6 +38,10 @@ type ChaosExperimentListerExpansion interface{}
 // ChaosExperimentNamespaceLister.
 type ChaosExperimentNamespaceListerExpansion interface{}
 
+// ChaosPolicyListerExpansion allows custom methods to be added to
+// ChaosPolicyLister.
+type ChaosPolicyListerExpansion interface{}
+
 // ChaosResultListerExpansion allows custom methods to be added to
 // ChaosResultLister.
 type ChaosResultListerExpansion interface{}
@@ -41,3 +49,11 @@ type ChaosResultListerExpansion interface{}
 // ChaosResultNamespaceListerExpansion allows custom methods to be added to
 // ChaosResultNamespaceLister.
 type ChaosResultNamespaceListerExpansion interface{}
+
+// ChaosScheduleListerExpansion allows custom methods to be added to
+// ChaosScheduleLister.
+type ChaosScheduleListerExpansion interface{}
+
+// ChaosScheduleNamespaceListerExpansion allows custom methods to be added to
+// ChaosScheduleNamespaceLister.
+type ChaosScheduleNamespaceListerExpansion interface{}
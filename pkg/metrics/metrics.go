@@ -0,0 +1,207 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics contains the prometheus metrics of the chaos lifecycle, which are
+// served by the metrics endpoint of the manager
+package metrics
+
+import (
+	"context"
+	"time"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "litmuschaos"
+
+// collectTimeout bounds the listing of the chaosengines and chaos-runner pods during a scrape
+const collectTimeout = 10 * time.Second
+
+var (
+	// EnginesStarted counts the chaosengines whose chaos-runner has been launched
+	EnginesStarted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "engines_started_total",
+		Help:      "Total number of chaosengine runs started",
+	}, []string{"namespace", "engine"})
+
+	// EnginesCompleted counts the chaosengines which have completed
+	EnginesCompleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "engines_completed_total",
+		Help:      "Total number of chaosengine runs completed",
+	}, []string{"namespace", "engine"})
+
+	// EnginesAborted counts the chaosengines which have been stopped before completion
+	EnginesAborted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "engines_aborted_total",
+		Help:      "Total number of chaosengine runs aborted",
+	}, []string{"namespace", "engine"})
+
+	// EnginesFailed counts the chaosengines which have failed
+	EnginesFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "engines_failed_total",
+		Help:      "Total number of chaosengine runs failed",
+	}, []string{"namespace", "engine"})
+
+	// EngineRunDuration observes the duration of the chaosengine runs, from the launch of the chaos-runner till the end of the run
+	EngineRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "engine_run_duration_seconds",
+		Help:      "Duration of the chaosengine runs in seconds",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 10),
+	}, []string{"namespace", "engine"})
+
+	// ExperimentVerdicts counts the verdicts of the chaos experiments
+	ExperimentVerdicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "experiment_verdicts_total",
+		Help:      "Total number of chaos experiment verdicts",
+	}, []string{"namespace", "engine", "experiment", "verdict"})
+
+	activeEnginesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "active_engines"),
+		"Number of chaosengines currently running",
+		[]string{"namespace", "engine"}, nil,
+	)
+
+	runnerPodsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "runner_pods"),
+		"Number of chaos-runner pods currently running",
+		[]string{"namespace", "engine"}, nil,
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		EnginesStarted,
+		EnginesCompleted,
+		EnginesAborted,
+		EnginesFailed,
+		EngineRunDuration,
+		ExperimentVerdicts,
+	)
+}
+
+// StateCollector computes the gauges of the running chaosengines and chaos-runner pods out of the
+// chaosengines and pods of the cluster on every scrape, so that they survive the restarts of the operator
+type StateCollector struct {
+	reader client.Reader
+}
+
+// NewStateCollector returns a StateCollector listing the chaosengines and pods through the reader,
+// which is meant to be the cached client of the manager
+func NewStateCollector(reader client.Reader) *StateCollector {
+	return &StateCollector{reader: reader}
+}
+
+// RegisterStateCollector registers the StateCollector listing through the reader with the metrics registry of the manager
+func RegisterStateCollector(reader client.Reader) error {
+	return metrics.Registry.Register(NewStateCollector(reader))
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *StateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeEnginesDesc
+	ch <- runnerPodsDesc
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *StateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	engineList := &litmuschaosv1alpha1.ChaosEngineList{}
+	if err := c.reader.List(ctx, engineList); err != nil {
+		chaosTypes.Log.Error(err, "unable to list chaosengines for the metrics")
+	} else {
+		activeEngines := map[engineKey]int{}
+		for _, engine := range engineList.Items {
+			if engine.Spec.EngineState == litmuschaosv1alpha1.EngineStateActive && engine.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
+				activeEngines[engineKey{namespace: engine.Namespace, engine: engine.Name}]++
+			}
+		}
+		collectPerEngine(ch, activeEnginesDesc, activeEngines)
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.reader.List(ctx, podList, client.MatchingLabels{"app.kubernetes.io/component": "chaos-runner"}); err != nil {
+		chaosTypes.Log.Error(err, "unable to list chaos-runner pods for the metrics")
+	} else {
+		runnerPods := map[engineKey]int{}
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodRunning {
+				runnerPods[engineKey{namespace: pod.Namespace, engine: pod.Labels["app"]}]++
+			}
+		}
+		collectPerEngine(ch, runnerPodsDesc, runnerPods)
+	}
+}
+
+// engineKey identifies the chaosengine of a gauge
+type engineKey struct {
+	namespace string
+	engine    string
+}
+
+// collectPerEngine sends the gauge of every chaosengine out of the counts
+func collectPerEngine(ch chan<- prometheus.Metric, desc *prometheus.Desc, counts map[engineKey]int) {
+	for key, count := range counts {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), key.namespace, key.engine)
+	}
+}
+
+// RecordEngineStarted records the launch of the chaos-runner for the chaosengine
+func RecordEngineStarted(namespace, engine string) {
+	EnginesStarted.WithLabelValues(namespace, engine).Inc()
+}
+
+// RecordEngineCompleted records the completion of the chaosengine run
+func RecordEngineCompleted(namespace, engine string, duration time.Duration) {
+	EnginesCompleted.WithLabelValues(namespace, engine).Inc()
+	recordEngineDuration(namespace, engine, duration)
+}
+
+// RecordEngineAborted records the abort of the chaosengine run
+func RecordEngineAborted(namespace, engine string, duration time.Duration) {
+	EnginesAborted.WithLabelValues(namespace, engine).Inc()
+	recordEngineDuration(namespace, engine, duration)
+}
+
+// RecordEngineFailed records the failure of the chaosengine run
+func RecordEngineFailed(namespace, engine string, duration time.Duration) {
+	EnginesFailed.WithLabelValues(namespace, engine).Inc()
+	recordEngineDuration(namespace, engine, duration)
+}
+
+// RecordExperimentVerdict records the verdict of the chaos experiment
+func RecordExperimentVerdict(namespace, engine, experiment, verdict string) {
+	ExperimentVerdicts.WithLabelValues(namespace, engine, experiment, verdict).Inc()
+}
+
+// recordEngineDuration observes the duration of the run, if it was started
+func recordEngineDuration(namespace, engine string, duration time.Duration) {
+	if duration > 0 {
+		EngineRunDuration.WithLabelValues(namespace, engine).Observe(duration.Seconds())
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2019 LitmusChaos Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestStateCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add the client-go scheme: %v", err)
+	}
+	if err := litmuschaosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add the litmuschaos scheme: %v", err)
+	}
+
+	engine := func(namespace, name string, state litmuschaosv1alpha1.EngineState, status litmuschaosv1alpha1.EngineStatus) client.Object {
+		return &litmuschaosv1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       litmuschaosv1alpha1.ChaosEngineSpec{EngineState: state},
+			Status:     litmuschaosv1alpha1.ChaosEngineStatus{EngineStatus: status},
+		}
+	}
+	runnerPod := func(namespace, engine string, phase corev1.PodPhase) client.Object {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      engine + "-runner",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": engine, "app.kubernetes.io/component": "chaos-runner"},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		engine("shop", "engine-1", litmuschaosv1alpha1.EngineStateActive, litmuschaosv1alpha1.EngineStatusInitialized),
+		engine("shop", "engine-2", litmuschaosv1alpha1.EngineStateActive, litmuschaosv1alpha1.EngineStatusInitialized),
+		engine("shop", "engine-3", litmuschaosv1alpha1.EngineStateActive, litmuschaosv1alpha1.EngineStatusCompleted),
+		engine("payments", "engine-1", litmuschaosv1alpha1.EngineStateActive, litmuschaosv1alpha1.EngineStatusInitialized),
+		engine("payments", "engine-2", litmuschaosv1alpha1.EngineStateStop, litmuschaosv1alpha1.EngineStatusStopped),
+		runnerPod("shop", "engine-1", corev1.PodRunning),
+		runnerPod("shop", "engine-2", corev1.PodRunning),
+		runnerPod("payments", "engine-1", corev1.PodSucceeded),
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "shop"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	).Build()
+
+	expected := `
+# HELP litmuschaos_active_engines Number of chaosengines currently running
+# TYPE litmuschaos_active_engines gauge
+litmuschaos_active_engines{engine="engine-1",namespace="payments"} 1
+litmuschaos_active_engines{engine="engine-1",namespace="shop"} 1
+litmuschaos_active_engines{engine="engine-2",namespace="shop"} 1
+# HELP litmuschaos_runner_pods Number of chaos-runner pods currently running
+# TYPE litmuschaos_runner_pods gauge
+litmuschaos_runner_pods{engine="engine-1",namespace="shop"} 1
+litmuschaos_runner_pods{engine="engine-2",namespace="shop"} 1
+`
+	if err := testutil.CollectAndCompare(NewStateCollector(reader), strings.NewReader(expected)); err != nil {
+		t.Fatalf("unexpected metrics: %v", err)
+	}
+}
@@ -42,11 +42,22 @@ var (
 	// Log with default name ie: controller_chaosengine
 	Log = log.Log.WithName("controller_chaosengine")
 
+	// ScheduleLog with default name ie: controller_chaosschedule
+	ScheduleLog = log.Log.WithName("controller_chaosschedule")
+
 	// DefaultChaosRunnerImage contains the default value of runner resource
-	DefaultChaosRunnerImage = "litmuschaos/chaos-runner:latest"
+	//
+	// Deprecated: use litmuschaosv1alpha1.DefaultChaosRunnerImage instead
+	DefaultChaosRunnerImage = litmuschaosv1alpha1.DefaultChaosRunnerImage
 
 	// ResultCRDName contains name of the chaosresult CRD
 	ResultCRDName = "chaosresults.litmuschaos.io"
+
+	// RunContextFileName contains the name of the run context file mounted inside the chaos-runner
+	RunContextFileName = "run-context.json"
+
+	// RunContextMountPath contains the path at which the run context is mounted inside the chaos-runner
+	RunContextMountPath = "/etc/litmus/run-context"
 )
 
 // EngineInfo Related information
@@ -54,7 +65,42 @@ type EngineInfo struct {
 	Instance       *litmuschaosv1alpha1.ChaosEngine
 	AppInfo        litmuschaosv1alpha1.ApplicationParams
 	Selectors      *litmuschaosv1alpha1.Selector
-	Targets        string
+	Targets        []Target
 	VolumeOpts     utils.VolumeOpts
 	AppExperiments []string
+	RunID          string
+}
+
+// Target is a target of the chaos, derived from the selectors or the appinfo of the chaosengine.
+// The chaos-runner receives the union of all the targets as a JSON encoded list
+type Target struct {
+	Kind          string   `json:"kind"`
+	APIVersion    string   `json:"apiVersion,omitempty"`
+	Namespace     string   `json:"namespace,omitempty"`
+	Names         []string `json:"names,omitempty"`
+	Labels        string   `json:"labels,omitempty"`
+	FieldSelector string   `json:"fieldSelector,omitempty"`
+	Pods          []string `json:"pods,omitempty"`
+	// IsAppInfo marks the target derived from the appinfo, whose labels select the pods rather than the workloads
+	IsAppInfo bool `json:"-"`
+}
+
+// RunContext contains the engine-level settings of a chaos run, which are
+// passed to the chaos-runner as a JSON file
+type RunContext struct {
+	RunID                         string                            `json:"runID"`
+	EngineName                    string                            `json:"engineName"`
+	EngineNamespace               string                            `json:"engineNamespace"`
+	EngineUID                     string                            `json:"engineUID"`
+	EngineLabels                  map[string]string                 `json:"engineLabels,omitempty"`
+	ChaosServiceAccount           string                            `json:"chaosServiceAccount,omitempty"`
+	DefaultHealthCheck            bool                              `json:"defaultHealthCheck"`
+	TerminationGracePeriodSeconds int64                             `json:"terminationGracePeriodSeconds,omitempty"`
+	ActiveDeadlineSeconds         int64                             `json:"activeDeadlineSeconds,omitempty"`
+	JobCleanUpPolicy              litmuschaosv1alpha1.CleanUpPolicy `json:"jobCleanUpPolicy,omitempty"`
+	AuxiliaryAppInfo              string                            `json:"auxiliaryAppInfo,omitempty"`
+	MaxTargetPercentage           int                               `json:"maxTargetPercentage,omitempty"`
+	MaxTargetCount                int                               `json:"maxTargetCount,omitempty"`
+	Targets                       []Target                          `json:"targets,omitempty"`
+	Experiments                   []string                          `json:"experiments"`
 }
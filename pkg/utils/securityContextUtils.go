@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MergeSecurityContexts merges the security contexts of the experiments into the strictest
+// security context satisfying all of them, i.e. privileges are only granted if every experiment
+// grants them, while restrictions are applied if any experiment applies them
+func MergeSecurityContexts(securityContexts []v1alpha1.SecurityContext) v1alpha1.SecurityContext {
+	var merged v1alpha1.SecurityContext
+	for i, sc := range securityContexts {
+		mergePodSecurityContext(&merged.PodSecurityContext, &sc.PodSecurityContext)
+		mergeContainerSecurityContext(&merged.ContainerSecurityContext, &sc.ContainerSecurityContext, i == 0)
+	}
+	return merged
+}
+
+// mergePodSecurityContext merges the pod security context into the merged pod security context
+func mergePodSecurityContext(merged, sc *corev1.PodSecurityContext) {
+	merged.RunAsNonRoot = mergeRestriction(merged.RunAsNonRoot, sc.RunAsNonRoot)
+	merged.RunAsUser = mergeID(merged.RunAsUser, sc.RunAsUser)
+	merged.RunAsGroup = mergeID(merged.RunAsGroup, sc.RunAsGroup)
+	merged.SeccompProfile = mergeSeccompProfile(merged.SeccompProfile, sc.SeccompProfile)
+
+	if merged.FSGroup == nil {
+		merged.FSGroup = sc.FSGroup
+	}
+	if merged.FSGroupChangePolicy == nil {
+		merged.FSGroupChangePolicy = sc.FSGroupChangePolicy
+	}
+	if merged.SELinuxOptions == nil {
+		merged.SELinuxOptions = sc.SELinuxOptions
+	}
+	if len(merged.SupplementalGroups) == 0 {
+		merged.SupplementalGroups = sc.SupplementalGroups
+	}
+	if len(merged.Sysctls) == 0 {
+		merged.Sysctls = sc.Sysctls
+	}
+}
+
+// mergeContainerSecurityContext merges the container security context into the merged container security context
+func mergeContainerSecurityContext(merged, sc *corev1.SecurityContext, isFirst bool) {
+	merged.Privileged = mergePrivilege(merged.Privileged, sc.Privileged, isFirst)
+	merged.AllowPrivilegeEscalation = mergePrivilege(merged.AllowPrivilegeEscalation, sc.AllowPrivilegeEscalation, isFirst)
+	merged.RunAsNonRoot = mergeRestriction(merged.RunAsNonRoot, sc.RunAsNonRoot)
+	merged.ReadOnlyRootFilesystem = mergeRestriction(merged.ReadOnlyRootFilesystem, sc.ReadOnlyRootFilesystem)
+	merged.RunAsUser = mergeID(merged.RunAsUser, sc.RunAsUser)
+	merged.RunAsGroup = mergeID(merged.RunAsGroup, sc.RunAsGroup)
+	merged.SeccompProfile = mergeSeccompProfile(merged.SeccompProfile, sc.SeccompProfile)
+	merged.Capabilities = mergeCapabilities(merged.Capabilities, sc.Capabilities, isFirst)
+
+	if merged.SELinuxOptions == nil {
+		merged.SELinuxOptions = sc.SELinuxOptions
+	}
+	if merged.ProcMount == nil {
+		merged.ProcMount = sc.ProcMount
+	}
+}
+
+// mergePrivilege grants the privilege only if it is granted by every security context
+func mergePrivilege(merged, value *bool, isFirst bool) *bool {
+	if isFirst {
+		return value
+	}
+	if merged == nil || value == nil || !*merged || !*value {
+		if merged == nil && value == nil {
+			return nil
+		}
+		return boolPtr(false)
+	}
+	return merged
+}
+
+// mergeRestriction applies the restriction if it is applied by any security context
+func mergeRestriction(merged, value *bool) *bool {
+	if value == nil || (merged != nil && *merged) {
+		return merged
+	}
+	return value
+}
+
+// mergeID prefers the non-root user or group ids over the root ones
+func mergeID(merged, value *int64) *int64 {
+	if merged == nil || (*merged == 0 && value != nil) {
+		return value
+	}
+	return merged
+}
+
+// mergeSeccompProfile prefers the confined seccomp profiles over the unconfined one
+func mergeSeccompProfile(merged, value *corev1.SeccompProfile) *corev1.SeccompProfile {
+	if merged == nil || (merged.Type == corev1.SeccompProfileTypeUnconfined && value != nil) {
+		return value
+	}
+	return merged
+}
+
+// mergeCapabilities only adds the capabilities added by every security context,
+// and drops the capabilities dropped by any of them
+func mergeCapabilities(merged, value *corev1.Capabilities, isFirst bool) *corev1.Capabilities {
+	if isFirst {
+		return value.DeepCopy()
+	}
+	if merged == nil && value == nil {
+		return nil
+	}
+
+	result := &corev1.Capabilities{}
+	if merged != nil && value != nil {
+		for _, capability := range merged.Add {
+			if containsCapability(value.Add, capability) {
+				result.Add = append(result.Add, capability)
+			}
+		}
+	}
+	for _, capabilities := range []*corev1.Capabilities{merged, value} {
+		if capabilities == nil {
+			continue
+		}
+		for _, capability := range capabilities.Drop {
+			if !containsCapability(result.Drop, capability) {
+				result.Drop = append(result.Drop, capability)
+			}
+		}
+	}
+	if len(result.Add) == 0 && len(result.Drop) == 0 {
+		return nil
+	}
+	return result
+}
+
+// containsCapability checks if the capability is present in the list of capabilities
+func containsCapability(capabilities []corev1.Capability, capability corev1.Capability) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestMergeSecurityContexts(t *testing.T) {
+	tests := map[string]struct {
+		securityContexts []v1alpha1.SecurityContext
+		expected         v1alpha1.SecurityContext
+	}{
+		"Test Positive-1": {
+			securityContexts: nil,
+			expected:         v1alpha1.SecurityContext{},
+		},
+		"Test Positive-2": {
+			securityContexts: []v1alpha1.SecurityContext{{}, {}},
+			expected:         v1alpha1.SecurityContext{},
+		},
+		"Test Positive-3": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					PodSecurityContext: v1.PodSecurityContext{
+						RunAsUser: int64Ptr(1000),
+					},
+					ContainerSecurityContext: v1.SecurityContext{
+						Privileged: boolPtr(true),
+						Capabilities: &v1.Capabilities{
+							Add: []v1.Capability{"NET_ADMIN"},
+						},
+					},
+				},
+			},
+			expected: v1alpha1.SecurityContext{
+				PodSecurityContext: v1.PodSecurityContext{
+					RunAsUser: int64Ptr(1000),
+				},
+				ContainerSecurityContext: v1.SecurityContext{
+					Privileged: boolPtr(true),
+					Capabilities: &v1.Capabilities{
+						Add: []v1.Capability{"NET_ADMIN"},
+					},
+				},
+			},
+		},
+		"Test Positive-4": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					PodSecurityContext: v1.PodSecurityContext{
+						RunAsNonRoot: boolPtr(false),
+						RunAsUser:    int64Ptr(0),
+						SeccompProfile: &v1.SeccompProfile{
+							Type: v1.SeccompProfileTypeUnconfined,
+						},
+					},
+					ContainerSecurityContext: v1.SecurityContext{
+						Privileged:               boolPtr(true),
+						AllowPrivilegeEscalation: boolPtr(true),
+						ReadOnlyRootFilesystem:   boolPtr(false),
+					},
+				},
+				{
+					PodSecurityContext: v1.PodSecurityContext{
+						RunAsNonRoot: boolPtr(true),
+						RunAsUser:    int64Ptr(1000),
+						SeccompProfile: &v1.SeccompProfile{
+							Type: v1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					ContainerSecurityContext: v1.SecurityContext{
+						Privileged:               boolPtr(false),
+						AllowPrivilegeEscalation: boolPtr(true),
+						ReadOnlyRootFilesystem:   boolPtr(true),
+					},
+				},
+			},
+			expected: v1alpha1.SecurityContext{
+				PodSecurityContext: v1.PodSecurityContext{
+					RunAsNonRoot: boolPtr(true),
+					RunAsUser:    int64Ptr(1000),
+					SeccompProfile: &v1.SeccompProfile{
+						Type: v1.SeccompProfileTypeRuntimeDefault,
+					},
+				},
+				ContainerSecurityContext: v1.SecurityContext{
+					Privileged:               boolPtr(false),
+					AllowPrivilegeEscalation: boolPtr(true),
+					ReadOnlyRootFilesystem:   boolPtr(true),
+				},
+			},
+		},
+		"Test Positive-5": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Privileged: boolPtr(true),
+					},
+				},
+				{},
+			},
+			expected: v1alpha1.SecurityContext{
+				ContainerSecurityContext: v1.SecurityContext{
+					Privileged: boolPtr(false),
+				},
+			},
+		},
+		"Test Positive-6": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Capabilities: &v1.Capabilities{
+							Add:  []v1.Capability{"NET_ADMIN", "SYS_ADMIN"},
+							Drop: []v1.Capability{"ALL"},
+						},
+					},
+				},
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Capabilities: &v1.Capabilities{
+							Add:  []v1.Capability{"NET_ADMIN"},
+							Drop: []v1.Capability{"NET_RAW", "ALL"},
+						},
+					},
+				},
+			},
+			expected: v1alpha1.SecurityContext{
+				ContainerSecurityContext: v1.SecurityContext{
+					Capabilities: &v1.Capabilities{
+						Add:  []v1.Capability{"NET_ADMIN"},
+						Drop: []v1.Capability{"ALL", "NET_RAW"},
+					},
+				},
+			},
+		},
+		"Test Positive-7": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Capabilities: &v1.Capabilities{
+							Add: []v1.Capability{"SYS_ADMIN"},
+						},
+					},
+				},
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Capabilities: &v1.Capabilities{
+							Drop: []v1.Capability{"NET_RAW"},
+						},
+					},
+				},
+			},
+			expected: v1alpha1.SecurityContext{
+				ContainerSecurityContext: v1.SecurityContext{
+					Capabilities: &v1.Capabilities{
+						Drop: []v1.Capability{"NET_RAW"},
+					},
+				},
+			},
+		},
+		"Test Positive-8": {
+			securityContexts: []v1alpha1.SecurityContext{
+				{
+					ContainerSecurityContext: v1.SecurityContext{
+						Capabilities: &v1.Capabilities{
+							Add: []v1.Capability{"SYS_ADMIN"},
+						},
+					},
+				},
+				{},
+			},
+			expected: v1alpha1.SecurityContext{},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			merged := MergeSecurityContexts(mock.securityContexts)
+			require.Equal(t, mock.expected, merged, "Test %q failed: unexpected merged security context", name)
+		})
+	}
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}